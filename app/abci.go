@@ -0,0 +1,62 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BeginBlock implements the ABCI BeginBlock, streaming and indexing the block
+func (app *IritaApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.BaseApp.BeginBlock(req)
+	if app.streamer != nil {
+		app.streamer.ListenBeginBlock(req, res)
+	}
+	if app.indexer != nil {
+		app.indexer.ListenBeginBlock(req)
+	}
+	return res
+}
+
+// DeliverTx implements the ABCI DeliverTx, streaming and indexing the tx
+func (app *IritaApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	if app.streamer != nil {
+		app.streamer.ListenDeliverTx(req, res)
+	}
+	if app.indexer != nil {
+		app.indexer.ListenDeliverTx(req, res)
+	}
+	return res
+}
+
+// EndBlock implements the ABCI EndBlock, streaming the request and the response
+func (app *IritaApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	if app.streamer != nil {
+		app.streamer.ListenEndBlock(req, res)
+	}
+	return res
+}
+
+// Commit implements the ABCI Commit, streaming and indexing the block once committed
+func (app *IritaApp) Commit() abci.ResponseCommit {
+	if app.streamer != nil {
+		// only the writes of the block state to the committed stores are streamed
+		app.streamer.BeginCommit()
+	}
+	res := app.BaseApp.Commit()
+	if app.streamer != nil {
+		if err := app.streamer.ListenCommit(res); err != nil {
+			if app.streamHaltOnError {
+				panic(err)
+			}
+			app.Logger().Error("failed to stream the block", "err", err.Error())
+		}
+	}
+	if app.indexer != nil {
+		// the indexer does not halt the node, the blocks failing to be indexed are logged
+		if err := app.indexer.ListenCommit(); err != nil {
+			app.Logger().Error("failed to index the block", "err", err.Error())
+		}
+	}
+	return res
+}
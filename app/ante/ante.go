@@ -16,19 +16,43 @@ import (
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	evmmoduleante "github.com/bianjieai/irita/modules/evm"
+	feeabskeeper "github.com/bianjieai/irita/modules/feeabs/keeper"
+	msgfilterkeeper "github.com/bianjieai/irita/modules/msgfilter/keeper"
+	permkeeper "github.com/bianjieai/irita/modules/perm/keeper"
+	"github.com/bianjieai/irita/modules/ratelimit"
+	ratelimitkeeper "github.com/bianjieai/irita/modules/ratelimit/keeper"
+	sm2keeper "github.com/bianjieai/irita/modules/sm2/keeper"
 )
 
 type HandlerOptions struct {
 	AccountKeeper   authkeeper.AccountKeeper
 	BankKeeper      bankkeeper.Keeper
-	FeegrantKeeper  authante.FeegrantKeeper
+	FeegrantKeeper  evmmoduleante.FeegrantKeeper
 	TokenKeeper     tokenkeeper.Keeper
 	SigGasConsumer  ante.SignatureVerificationGasConsumer
 	SignModeHandler signing.SignModeHandler
+	PermKeeper      permkeeper.Keeper
+	// FeeAbsKeeper prices the fees paid in the fee tokens, nil if the feeabs module is disabled
+	FeeAbsKeeper *feeabskeeper.Keeper
+	// RateLimitKeeper enforces the rate limits of the ratelimit module params
+	RateLimitKeeper ratelimitkeeper.Keeper
+	// RateLimiter enforces the rate limits of the node configuration in CheckTx, nil if not configured
+	RateLimiter *ratelimit.Limiter
+	// MsgFilterKeeper rejects the messages whose type is not allowed
+	MsgFilterKeeper msgfilterkeeper.Keeper
 
 	// evm config
 	EvmKeeper          evmmoduleante.EVMKeeper
 	EvmFeeMarketKeeper evmtypes.FeeMarketKeeper
+	ContractCallable   evmmoduleante.ContractCallable
+	ContractDeployable evmmoduleante.ContractDeployable
+	// ContractSponsorable gets the sponsors paying the fees of the calls to the contracts
+	ContractSponsorable evmmoduleante.ContractSponsorable
+	// Sm2Keeper provides the hash algorithm of the EVM txs signed with SM2 keys
+	Sm2Keeper sm2keeper.Keeper
+
+	// ExtraDecorators are appended to the ante handlers, e.g. by the app plugins
+	ExtraDecorators []sdk.AnteDecorator
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -41,11 +65,28 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		var anteHandler sdk.AnteHandler
 
 		//defer Recover(ctx.Logger(), &err)
+
+		// the messages whose type is not allowed are rejected whatever the ante handler of the tx,
+		// including the messages nested in other messages
+		if err := options.MsgFilterKeeper.ValidateMsgs(ctx, tx.GetMsgs()); err != nil {
+			return ctx, err
+		}
+
 		txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
 		if ok {
 			opts := txWithExtensions.GetExtensionOptions()
 			if len(opts) > 0 {
-				switch typeURL := opts[0].GetTypeUrl(); typeURL {
+				typeURL := opts[0].GetTypeUrl()
+				// the ethereum txs need the evm module
+				if options.EvmKeeper == nil {
+					return ctx, sdkerrors.Wrapf(
+						sdkerrors.ErrUnknownExtensionOptions,
+						"rejecting tx with extension option %s: the evm module is disabled",
+						typeURL,
+					)
+				}
+
+				switch typeURL {
 				case "/ethermint.evm.v1.ExtensionOptionsEthereumTx":
 					// handle as *evmtypes.MsgEthereumTx
 					anteHandler = newEthAnteHandler(options)
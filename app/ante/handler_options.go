@@ -3,17 +3,28 @@ package ante
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	ethermintante "github.com/tharsis/ethermint/app/ante"
 
 	evmmoduleante "github.com/bianjieai/irita/modules/evm"
+	"github.com/bianjieai/irita/modules/feeabs"
+	"github.com/bianjieai/irita/modules/perm"
+	"github.com/bianjieai/irita/modules/proposal"
+	proposaltypes "github.com/bianjieai/irita/modules/proposal/types"
+	"github.com/bianjieai/irita/modules/ratelimit"
 )
 
 func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
+	decorators := []sdk.AnteDecorator{
 		ethermintante.NewEthSetUpContextDecorator(options.EvmKeeper), // outermost AnteDecorator. SetUpContext must be called first
 
-		evmmoduleante.NewEthSigVerificationDecorator(options.EvmKeeper, options.AccountKeeper, options.SignModeHandler),
+		evmmoduleante.NewEthSigVerificationDecorator(options.EvmKeeper, options.AccountKeeper, options.Sm2Keeper, options.SignModeHandler),
+		evmmoduleante.NewEthContractCallableDecorator(options.ContractCallable),
+		evmmoduleante.NewEthContractDeployerDecorator(options.ContractDeployable),
+		perm.NewFreezeDecorator(options.PermKeeper),
+		ratelimit.NewRateLimitDecorator(options.RateLimitKeeper, options.RateLimiter),
+		evmmoduleante.NewEthFeeSponsorDecorator(options.EvmKeeper, options.AccountKeeper, options.FeegrantKeeper, options.ContractSponsorable),
 
 		ethermintante.NewEthMempoolFeeDecorator(options.EvmKeeper), // Check eth effective gas price against minimal-gas-prices
 		ethermintante.NewEthValidateBasicDecorator(options.EvmKeeper),
@@ -22,51 +33,84 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ethermintante.NewCanTransferDecorator(options.EvmKeeper),
 		ethermintante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper), // innermost AnteDecorator.
 
-	)
+	}
+
+	return sdk.ChainAnteDecorators(append(decorators, options.ExtraDecorators...)...)
 }
 
 func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
+	decorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		ante.NewMempoolFeeDecorator(),
+		newMempoolFeeDecorator(options),
 		ante.NewValidateBasicDecorator(),
+		// the params and upgrade messages are only executed through the admin proposals
+		proposal.NewAuthorityDecorator(authtypes.NewModuleAddress(proposaltypes.ModuleName)),
+		perm.NewFreezeDecorator(options.PermKeeper),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		newDeductFeeDecorator(options),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// the txs are rate limited once their signers are verified, for the forged txs not to consume their limits
+		ratelimit.NewRateLimitDecorator(options.RateLimitKeeper, options.RateLimiter),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ante.NewRejectExtensionOptionsDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		tokenkeeper.NewValidateTokenFeeDecorator(options.TokenKeeper, options.BankKeeper),
-	)
+	}
+
+	return sdk.ChainAnteDecorators(append(decorators, options.ExtraDecorators...)...)
 }
 
 func newCosmosAnteHandlerEip712(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
+	decorators := []sdk.AnteDecorator{
 		ethermintante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
 		ante.NewSetUpContextDecorator(),
 
 		// perm check
-		evmmoduleante.NewEthSigVerificationDecorator(options.EvmKeeper, options.AccountKeeper, options.SignModeHandler),
+		evmmoduleante.NewEthSigVerificationDecorator(options.EvmKeeper, options.AccountKeeper, options.Sm2Keeper, options.SignModeHandler),
 
 		// NOTE: extensions option decorator removed
 		// ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+		newMempoolFeeDecorator(options),
 		ante.NewValidateBasicDecorator(),
+		// the params and upgrade messages are only executed through the admin proposals
+		proposal.NewAuthorityDecorator(authtypes.NewModuleAddress(proposaltypes.ModuleName)),
+		perm.NewFreezeDecorator(options.PermKeeper),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		newDeductFeeDecorator(options),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		// Note: signature verification uses EIP instead of the cosmos signature validator
 		ethermintante.NewEip712SigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// the txs are rate limited once their signers are verified, for the forged txs not to consume their limits
+		ratelimit.NewRateLimitDecorator(options.RateLimitKeeper, options.RateLimiter),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-	)
+	}
+
+	return sdk.ChainAnteDecorators(append(decorators, options.ExtraDecorators...)...)
+}
+
+// newMempoolFeeDecorator returns the decorator checking the fees against the min gas
+// prices, accepting the fees paid in the fee tokens if the feeabs module is enabled
+func newMempoolFeeDecorator(options HandlerOptions) sdk.AnteDecorator {
+	if options.FeeAbsKeeper == nil {
+		return ante.NewMempoolFeeDecorator()
+	}
+	return feeabs.NewMempoolFeeDecorator(*options.FeeAbsKeeper)
+}
 
+// newDeductFeeDecorator returns the decorator deducting the fees, collecting the
+// fees paid in the fee tokens if the feeabs module is enabled
+func newDeductFeeDecorator(options HandlerOptions) sdk.AnteDecorator {
+	if options.FeeAbsKeeper == nil {
+		return ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper)
+	}
+	return feeabs.NewDeductFeeDecorator(*options.FeeAbsKeeper, options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper)
 }
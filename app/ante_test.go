@@ -0,0 +1,102 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	nodetypes "github.com/bianjieai/iritamod/modules/node/types"
+
+	"github.com/bianjieai/irita/modules/ratelimit"
+	ratelimittypes "github.com/bianjieai/irita/modules/ratelimit/types"
+)
+
+func TestRateLimitForgedTx(t *testing.T) {
+	appOpts := mapAppOptions{
+		ratelimit.FlagWindowBlocks:     uint64(10),
+		ratelimit.FlagMaxTxsPerAccount: uint64(1),
+		// the fee market BeginBlocker requires the consensus params
+		FlagDisabledModules: []string{"evm"},
+	}
+	app := NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), appOpts)
+	require.NoError(t, setGenesis(app))
+
+	priv := secp256k1.GenPrivKey()
+	account := createAccount(app, priv)
+	addr := account.GetAddress()
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("upoint", 1)))
+
+	// the tx signed for another chain fails the signature verification, without consuming the limit of its signer
+	res := app.CheckTx(abci.RequestCheckTx{Tx: signTx(t, "other", priv, account.GetAccountNumber(), 0, msg)})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: signTx(t, "irita_1000-1", priv, account.GetAccountNumber(), 0, msg)})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: signTx(t, "irita_1000-1", priv, account.GetAccountNumber(), 1, msg)})
+	require.Equal(t, ratelimittypes.ErrRateLimited.ABCICode(), res.Code, res.Log)
+	require.Equal(t, ratelimittypes.ErrRateLimited.Codespace(), res.Codespace)
+}
+
+func TestSponsoredEthTxRefund(t *testing.T) {
+	app := NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), mapAppOptions{})
+	require.NoError(t, setGenesis(app))
+
+	// the block proposer is the coinbase of the EVM
+	consKey := ed25519.GenPrivKey().PubKey()
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, ChainID: "irita_1000-1", ProposerAddress: consKey.Address()}
+	ctx := app.BaseApp.NewContext(true, header)
+	app.EvmKeeper.WithChainID(ctx)
+	validator := nodetypes.NewValidator(consKey.Address(), "validator", "", consKey, "", 100, sdk.AccAddress(consKey.Address()))
+	app.nodeKeeper.SetValidator(ctx, validator)
+	app.nodeKeeper.SetValidatorConsAddrIndex(ctx, consKey.Address(), sdk.ConsAddress(consKey.Address()))
+
+	key, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := common.BytesToAddress(key.PubKey().Address())
+	sponsor := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	recipient := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, sender.Bytes()))
+
+	// the fee of the tx, deducted from the sponsor by the ante handler
+	const gasLimit, gasPrice = 100000, 10
+	denom := app.EvmKeeper.GetParams(ctx).EvmDenom
+	fee := sdk.NewCoins(sdk.NewInt64Coin(denom, gasLimit*gasPrice))
+	require.NoError(t, app.bankKeeper.MintCoins(ctx, evmtypes.ModuleName, fee))
+	require.NoError(t, app.bankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fee))
+
+	ecdsaKey, err := key.ToECDSA()
+	require.NoError(t, err)
+	msg := evmtypes.NewTx(app.EvmKeeper.ChainID(), 0, &recipient, big.NewInt(0), gasLimit, big.NewInt(gasPrice), nil, nil, nil, nil)
+	tx, err := ethtypes.SignTx(msg.AsTransaction(), ethtypes.LatestSignerForChainID(app.EvmKeeper.ChainID()), ecdsaKey)
+	require.NoError(t, err)
+	require.NoError(t, msg.FromEthereumTx(tx))
+	msg.From = sender.Hex()
+	msg.FeePayer = sponsor.Hex()
+
+	res, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+	var txRes evmtypes.MsgEthereumTxResponse
+	require.NoError(t, app.appCodec.Unmarshal(res.Data, &txRes))
+	require.False(t, txRes.Failed(), txRes.VmError)
+
+	// the unused gas is refunded to the sponsor, not to the sender
+	refund := int64(gasLimit-txRes.GasUsed) * gasPrice
+	require.Equal(t, refund, app.bankKeeper.GetBalance(ctx, sponsor.Bytes(), denom).Amount.Int64())
+	require.True(t, app.bankKeeper.GetBalance(ctx, sender.Bytes(), denom).IsZero())
+}
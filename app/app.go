@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"io"
 	"math"
 	"os"
@@ -36,9 +37,13 @@ import (
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -59,6 +64,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	sdkupgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
 	"github.com/irisnet/irismod/modules/mt"
 	mtkeeper "github.com/irisnet/irismod/modules/mt/keeper"
 	mttypes "github.com/irisnet/irismod/modules/mt/types"
@@ -84,6 +91,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	ethermintante "github.com/tharsis/ethermint/app/ante"
 	srvflags "github.com/tharsis/ethermint/server/flags"
@@ -98,12 +106,42 @@ import (
 
 	"github.com/bianjieai/irita/address"
 	appante "github.com/bianjieai/irita/app/ante"
+	"github.com/bianjieai/irita/app/indexer"
+	"github.com/bianjieai/irita/app/streaming"
 	"github.com/bianjieai/irita/lite"
+	"github.com/bianjieai/irita/modules/coinswap"
+	"github.com/bianjieai/irita/modules/erc20"
+	erc20keeper "github.com/bianjieai/irita/modules/erc20/keeper"
+	erc20types "github.com/bianjieai/irita/modules/erc20/types"
 	appkeeper "github.com/bianjieai/irita/modules/evm"
 	"github.com/bianjieai/irita/modules/evm/crypto"
+	"github.com/bianjieai/irita/modules/evm/precompile"
 	evmutils "github.com/bianjieai/irita/modules/evm/utils"
+	"github.com/bianjieai/irita/modules/feeabs"
+	feeabskeeper "github.com/bianjieai/irita/modules/feeabs/keeper"
+	feeabstypes "github.com/bianjieai/irita/modules/feeabs/types"
+	"github.com/bianjieai/irita/modules/msgfilter"
+	msgfilterkeeper "github.com/bianjieai/irita/modules/msgfilter/keeper"
+	msgfiltertypes "github.com/bianjieai/irita/modules/msgfilter/types"
+	"github.com/bianjieai/irita/modules/perm"
+	permkeeper "github.com/bianjieai/irita/modules/perm/keeper"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/modules/proposal"
+	proposalkeeper "github.com/bianjieai/irita/modules/proposal/keeper"
+	proposaltypes "github.com/bianjieai/irita/modules/proposal/types"
+	"github.com/bianjieai/irita/modules/ratelimit"
+	ratelimitkeeper "github.com/bianjieai/irita/modules/ratelimit/keeper"
+	ratelimittypes "github.com/bianjieai/irita/modules/ratelimit/types"
+	sm2module "github.com/bianjieai/irita/modules/sm2"
+	sm2keeper "github.com/bianjieai/irita/modules/sm2/keeper"
+	sm2types "github.com/bianjieai/irita/modules/sm2/types"
 	tibc "github.com/bianjieai/irita/modules/tibc"
+	fttransfer "github.com/bianjieai/irita/modules/tibc/apps/ft_transfer"
+	fttransferkeeper "github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/keeper"
+	fttransfertypes "github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
 	tibckeeper "github.com/bianjieai/irita/modules/tibc/keeper"
+	"github.com/bianjieai/irita/modules/vesting"
+	vestingtypes "github.com/bianjieai/irita/modules/vesting/types"
 	"github.com/bianjieai/iritamod/modules/genutil"
 	genutiltypes "github.com/bianjieai/iritamod/modules/genutil"
 	"github.com/bianjieai/iritamod/modules/identity"
@@ -126,24 +164,43 @@ var storeKeys = []string{
 	banktypes.StoreKey,
 	slashingtypes.StoreKey,
 	paramstypes.StoreKey,
+	capabilitytypes.StoreKey,
 	upgradetypes.StoreKey,
 	feegrant.StoreKey,
+	authzkeeper.StoreKey,
 	evidencetypes.StoreKey,
 	recordtypes.StoreKey,
 	tokentypes.StoreKey,
 	nfttypes.StoreKey,
 	mttypes.StoreKey,
+	coinswaptypes.StoreKey,
 	servicetypes.StoreKey,
 	oracletypes.StoreKey,
 	randomtypes.StoreKey,
 	identitytypes.StoreKey,
 	nodetypes.StoreKey,
+	permtypes.StoreKey,
+	proposaltypes.StoreKey,
+	feeabstypes.StoreKey,
+	ratelimittypes.StoreKey,
 	tibchost.StoreKey,
 	tibcnfttypes.StoreKey,
 	tibcmttypes.StoreKey,
+	fttransfertypes.StoreKey,
 
 	// evm
-	evmtypes.StoreKey, feemarkettypes.StoreKey,
+	evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey,
+}
+
+var transientStoreKeys = []string{
+	paramstypes.TStoreKey,
+
+	// evm
+	evmtypes.TransientKey,
+}
+
+var memoryStoreKeys = []string{
+	capabilitytypes.MemStoreKey,
 }
 
 // DefaultNodeHome default home directories for the application daemon
@@ -159,44 +216,58 @@ var (
 		bank.AppModuleBasic{},
 		params.AppModuleBasic{},
 		cparams.AppModuleBasic{},
+		capability.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		cslashing.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
+		authzmodule.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		record.AppModuleBasic{},
 		token.AppModuleBasic{},
 		nft.AppModuleBasic{},
 		mt.AppModuleBasic{},
+		coinswap.AppModuleBasic{},
 		service.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		random.AppModuleBasic{},
 		identity.AppModuleBasic{},
 		node.AppModuleBasic{},
+		perm.AppModuleBasic{},
+		proposal.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		msgfilter.AppModuleBasic{},
+		sm2module.AppModuleBasic{},
 		tibc.AppModule{},
 		tibcnfttransfer.AppModuleBasic{},
 		tibcmttransfer.AppModuleBasic{},
+		fttransfer.AppModuleBasic{},
 
 		// evm
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 	)
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:  nil,
 		tokentypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		coinswaptypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		servicetypes.DepositAccName: nil,
 		servicetypes.RequestAccName: nil,
 		tibcnfttypes.ModuleName:     nil,
 		tibcmttypes.ModuleName:      nil,
+		fttransfertypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
+		proposaltypes.ModuleName:    nil,
 
 		// evm
-		evmtypes.ModuleName: {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmtypes.ModuleName:   {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName: {authtypes.Minter, authtypes.Burner},
 	}
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{}
-	// app options
-	appOptions = IritaAppOptions{}
 )
 
 // Verify app interface at compile time
@@ -234,6 +305,13 @@ type IritaApp struct {
 
 	invCheckPeriod uint
 
+	// the node home, resolving the streamed app state file
+	homePath string
+
+	// the optional modules enabled on the node and their module accounts permissions
+	modules   ModuleSelection
+	maccPerms map[string][]string
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -241,7 +319,7 @@ type IritaApp struct {
 
 	// keepers
 	accountKeeper    authkeeper.AccountKeeper
-	bankKeeper       bankkeeper.Keeper
+	bankKeeper       permkeeper.BankKeeper
 	slashingKeeper   slashingkeeper.Keeper
 	crisisKeeper     crisiskeeper.Keeper
 	upgradeKeeper    upgradekeeper.Keeper
@@ -251,23 +329,35 @@ type IritaApp struct {
 	tokenKeeper      tokenkeeper.Keeper
 	nftKeeper        nftkeeper.Keeper
 	mtKeeper         mtkeeper.Keeper
+	coinswapKeeper   coinswapkeeper.Keeper
 	serviceKeeper    servicekeeper.Keeper
 	oracleKeeper     oraclekeeper.Keeper
 	randomKeeper     randomkeeper.Keeper
 	identityKeeper   identitykeeper.Keeper
 	nodeKeeper       nodekeeper.Keeper
+	permKeeper       permkeeper.Keeper
+	proposalKeeper   proposalkeeper.Keeper
+	feeAbsKeeper     feeabskeeper.Keeper
+	rateLimitKeeper  ratelimitkeeper.Keeper
+	msgFilterKeeper  msgfilterkeeper.Keeper
+	sm2Keeper        sm2keeper.Keeper
 	feeGrantKeeper   feegrantkeeper.Keeper
+	authzKeeper      authzkeeper.Keeper
 	capabilityKeeper *capabilitykeeper.Keeper
 	// tibc
-	scopedTIBCKeeper     capabilitykeeper.ScopedKeeper
-	scopedTIBCMockKeeper capabilitykeeper.ScopedKeeper
-	tibcKeeper           *tibckeeper.Keeper
-	nftTransferKeeper    tibcnfttransferkeeper.Keeper
-	mtTransferKeeper     tibcmttransferkeeper.Keeper
+	scopedTIBCKeeper  capabilitykeeper.ScopedKeeper
+	tibcKeeper        *tibckeeper.Keeper
+	nftTransferKeeper tibcnfttransferkeeper.Keeper
+	mtTransferKeeper  tibcmttransferkeeper.Keeper
+	ftTransferKeeper  fttransferkeeper.Keeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
+	erc20Keeper     erc20keeper.Keeper
+
+	// the precompiled contracts exposing the modules to the EVM
+	precompiles *precompile.Registry
 
 	// the module manager
 	mm *module.Manager
@@ -277,6 +367,16 @@ type IritaApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// streamer of the committed blocks, nil if streaming is disabled
+	streamer          *streaming.Streamer
+	streamHaltOnError bool
+
+	// indexer of the committed txs, nil if indexing is disabled
+	indexer *indexer.Indexer
+
+	// rate limiter of the txs entering the mempool, nil if not configured
+	rateLimiter *ratelimit.Limiter
 }
 
 // NewIritaApp returns a reference to an initialized IritaApp.
@@ -297,9 +397,18 @@ func NewIritaApp(
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 
-	keys := sdk.NewKVStoreKeys(storeKeys...)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey)
-	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+	modules, err := newModuleSelection(appOpts)
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+	// the stores of the enabled modules make the app hash, so they must be those of the chain
+	if err := modules.checkChain(db); err != nil {
+		tmos.Exit(err.Error())
+	}
+
+	keys := sdk.NewKVStoreKeys(modules.storeKeys(storeKeys)...)
+	tkeys := sdk.NewTransientStoreKeys(modules.transientStoreKeys(transientStoreKeys)...)
+	memKeys := sdk.NewMemoryStoreKeys(memoryStoreKeys...)
 
 	app := &IritaApp{
 		BaseApp:           bApp,
@@ -307,6 +416,9 @@ func NewIritaApp(
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		homePath:          homePath,
+		modules:           modules,
+		maccPerms:         modules.maccPerms(maccPerms),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
@@ -317,12 +429,22 @@ func NewIritaApp(
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.paramsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
 
+	// add capability keeper and ScopeToModule for the modules claiming capabilities
+	app.capabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
+	if modules.IsEnabled(tibchost.ModuleName) {
+		app.scopedTIBCKeeper = app.capabilityKeeper.ScopeToModule(tibchost.ModuleName)
+	}
+
 	// add keepers
 	app.accountKeeper = authkeeper.NewAccountKeeper(
-		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
+		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, app.maccPerms,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
-		appCodec, keys[banktypes.StoreKey], app.accountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
+	app.permKeeper = permkeeper.NewKeeper(appCodec, keys[permtypes.StoreKey], authtypes.NewModuleAddress(proposaltypes.ModuleName))
+	app.bankKeeper = permkeeper.WrapBankKeeper(
+		bankkeeper.NewBaseKeeper(
+			appCodec, keys[banktypes.StoreKey], app.accountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
+		),
+		app.permKeeper,
 	)
 	app.nodeKeeper = node.NewKeeper(appCodec, keys[nodetypes.StoreKey], app.GetSubspace(node.ModuleName))
 	app.slashingKeeper = slashingkeeper.NewKeeper(
@@ -332,6 +454,16 @@ func NewIritaApp(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.bankKeeper, authtypes.FeeCollectorName,
 	)
 	app.feeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.accountKeeper)
+	app.authzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter())
+	app.proposalKeeper = proposalkeeper.NewKeeper(
+		appCodec, keys[proposaltypes.StoreKey], app.GetSubspace(proposaltypes.ModuleName),
+		app.accountKeeper, app.permKeeper, app.MsgServiceRouter(),
+	)
+	app.rateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec, keys[ratelimittypes.StoreKey], app.GetSubspace(ratelimittypes.ModuleName), app.permKeeper,
+	)
+	app.msgFilterKeeper = msgfilterkeeper.NewKeeper(app.GetSubspace(msgfiltertypes.ModuleName), app.permKeeper)
+	app.sm2Keeper = sm2keeper.NewKeeper(app.GetSubspace(sm2types.ModuleName))
 
 	sdkUpgradeKeeper := sdkupgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 	app.upgradeKeeper = upgradekeeper.NewKeeper(sdkUpgradeKeeper)
@@ -350,20 +482,51 @@ func NewIritaApp(
 
 	app.recordKeeper = recordkeeper.NewKeeper(appCodec, keys[recordtypes.StoreKey])
 	app.nftKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
-	app.mtKeeper = mtkeeper.NewKeeper(appCodec, keys[mttypes.StoreKey])
 
-	app.serviceKeeper = servicekeeper.NewKeeper(
-		appCodec, keys[servicetypes.StoreKey], app.accountKeeper, app.bankKeeper,
-		app.GetSubspace(servicetypes.ModuleName), app.ModuleAccountAddrs(),
-		servicetypes.FeeCollectorName,
-	)
+	// the keepers of the disabled modules are not created as their module accounts
+	// and stores do not exist
+	if modules.IsEnabled(mttypes.ModuleName) {
+		app.mtKeeper = mtkeeper.NewKeeper(appCodec, keys[mttypes.StoreKey])
+	}
 
-	app.oracleKeeper = oraclekeeper.NewKeeper(
-		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
-		app.serviceKeeper,
-	)
+	if modules.IsEnabled(coinswaptypes.ModuleName) {
+		app.coinswapKeeper = coinswapkeeper.NewKeeper(
+			appCodec, keys[coinswaptypes.StoreKey], app.GetSubspace(coinswaptypes.ModuleName),
+			app.bankKeeper, app.accountKeeper, app.ModuleAccountAddrs(), authtypes.FeeCollectorName,
+		)
+	}
+
+	if modules.IsEnabled(servicetypes.ModuleName) {
+		app.serviceKeeper = servicekeeper.NewKeeper(
+			appCodec, keys[servicetypes.StoreKey], app.accountKeeper, app.bankKeeper,
+			app.GetSubspace(servicetypes.ModuleName), app.ModuleAccountAddrs(),
+			servicetypes.FeeCollectorName,
+		)
+	}
 
-	app.randomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.bankKeeper, app.serviceKeeper)
+	if modules.IsEnabled(oracletypes.ModuleName) {
+		app.oracleKeeper = oraclekeeper.NewKeeper(
+			appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
+			app.serviceKeeper,
+		)
+	}
+
+	if modules.IsEnabled(feeabstypes.ModuleName) {
+		// the fee tokens are not swapped when the coinswap module is disabled
+		var coinswapKeeper feeabstypes.CoinswapKeeper
+		if modules.IsEnabled(coinswaptypes.ModuleName) {
+			coinswapKeeper = app.coinswapKeeper
+		}
+		app.feeAbsKeeper = feeabskeeper.NewKeeper(
+			appCodec, keys[feeabstypes.StoreKey], app.GetSubspace(feeabstypes.ModuleName),
+			app.accountKeeper, app.bankKeeper, app.permKeeper, app.tokenKeeper, app.oracleKeeper, coinswapKeeper,
+			tokentypes.GetNativeToken().MinUnit, authtypes.FeeCollectorName,
+		)
+	}
+
+	if modules.IsEnabled(randomtypes.ModuleName) {
+		app.randomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.bankKeeper, app.serviceKeeper)
+	}
 
 	app.nodeKeeper = *app.nodeKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.slashingKeeper.Hooks()),
@@ -371,42 +534,83 @@ func NewIritaApp(
 
 	app.identityKeeper = identitykeeper.NewKeeper(appCodec, keys[identitytypes.StoreKey])
 
-	// evm
-	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
+	if modules.IsEnabled(evmtypes.ModuleName) {
+		// evm
+		tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
+
+		// Create Ethermint  keepers
+		app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+			appCodec, keys[feemarkettypes.StoreKey], app.GetSubspace(feemarkettypes.ModuleName),
+		)
+		app.EvmKeeper = evmkeeper.NewKeeper(
+			appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], app.GetSubspace(evmtypes.ModuleName),
+			app.accountKeeper, app.bankKeeper, appkeeper.WNodeKeeper{Keeper: app.nodeKeeper}, app.FeeMarketKeeper,
+			tracer, // debug EVM based on Baseapp options
+		)
+
+		app.EvmKeeper.AccStoreKey = keys[authtypes.StoreKey]
+
+		app.precompiles = precompile.NewRegistry(app.MsgServiceRouter(), app.msgFilterKeeper, app.permKeeper)
+		app.precompiles.Register(
+			precompile.NewNFTContract(app.nftKeeper),
+			precompile.NewTokenContract(app.tokenKeeper, app.bankKeeper, app.EvmKeeper),
+			precompile.NewRecordContract(app.recordKeeper),
+			precompile.NewIdentityContract(app.identityKeeper),
+		)
+		if modules.IsEnabled(mttypes.ModuleName) {
+			app.precompiles.Register(precompile.NewMTContract(app.mtKeeper))
+		}
+		if modules.IsEnabled(randomtypes.ModuleName) {
+			app.precompiles.Register(precompile.NewRandomContract(app.randomKeeper))
+		}
+		app.EvmKeeper.Transfer = app.precompiles.Transfer
+		app.EvmKeeper.SetHooks(app.precompiles)
+
+		// the ERC20 contracts are called in the EVM executions of the precompiled contracts registry
+		app.erc20Keeper = erc20keeper.NewKeeper(
+			appCodec, keys[erc20types.StoreKey],
+			app.accountKeeper, app.bankKeeper, app.permKeeper, app.tokenKeeper,
+			app.EvmKeeper, app.precompiles,
+		)
+	}
 
-	// Create Ethermint  keepers
-	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
-		appCodec, keys[feemarkettypes.StoreKey], app.GetSubspace(feemarkettypes.ModuleName),
-	)
-	app.EvmKeeper = evmkeeper.NewKeeper(
-		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], app.GetSubspace(evmtypes.ModuleName),
-		app.accountKeeper, app.bankKeeper, appkeeper.WNodeKeeper{Keeper: app.nodeKeeper}, app.FeeMarketKeeper,
-		tracer, // debug EVM based on Baseapp options
-	)
+	if modules.IsEnabled(tibchost.ModuleName) {
+		// register the proposal types
+		tibccorekeeper := tibccorekeeper.NewKeeper(
+			appCodec, keys[tibchost.StoreKey], app.GetSubspace(tibchost.ModuleName), stakingkeeper.Keeper{},
+		)
+		app.tibcKeeper = tibckeeper.NewKeeper(tibccorekeeper, app.permKeeper)
+		app.nftTransferKeeper = tibcnfttransferkeeper.NewKeeper(
+			appCodec, keys[tibcnfttypes.StoreKey], app.GetSubspace(tibcnfttypes.ModuleName),
+			app.accountKeeper, tibckeeper.WrapNftKeeper(app.nftKeeper),
+			app.tibcKeeper.PacketKeeper, app.tibcKeeper.ClientKeeper,
+		)
+		app.mtTransferKeeper = tibcmttransferkeeper.NewKeeper(
+			appCodec, keys[tibcmttypes.StoreKey], app.GetSubspace(tibcmttypes.ModuleName),
+			app.accountKeeper, app.mtKeeper,
+			app.tibcKeeper.PacketKeeper, app.tibcKeeper.ClientKeeper,
+		)
+		app.ftTransferKeeper = fttransferkeeper.NewKeeper(
+			appCodec, keys[fttransfertypes.StoreKey],
+			app.accountKeeper, app.bankKeeper, app.tokenKeeper,
+			app.tibcKeeper.PacketKeeper, app.tibcKeeper.ClientKeeper,
+		)
+		tibcRouter := tibcroutingtypes.NewRouter()
+		tibcRouter.AddRoute(tibcnfttypes.ModuleName, tibcnfttransfer.NewAppModule(app.nftTransferKeeper))
+		tibcRouter.AddRoute(tibcmttypes.ModuleName, tibcmttransfer.NewAppModule(app.mtTransferKeeper))
+		tibcRouter.AddRoute(fttransfertypes.PortID, fttransfer.NewAppModule(app.ftTransferKeeper))
+		app.tibcKeeper.SetRouter(tibcRouter)
+	}
 
-	app.EvmKeeper.AccStoreKey = keys[authtypes.StoreKey]
+	// the enabled optional modules
+	optionalModules := app.optionalAppModules()
 
-	// register the proposal types
-	tibccorekeeper := tibccorekeeper.NewKeeper(
-		appCodec, keys[tibchost.StoreKey], app.GetSubspace(tibchost.ModuleName), stakingkeeper.Keeper{},
-	)
-	app.tibcKeeper = tibckeeper.NewKeeper(tibccorekeeper)
-	app.nftTransferKeeper = tibcnfttransferkeeper.NewKeeper(
-		appCodec, keys[tibcnfttypes.StoreKey], app.GetSubspace(tibcnfttypes.ModuleName),
-		app.accountKeeper, tibckeeper.WrapNftKeeper(app.nftKeeper),
-		app.tibcKeeper.PacketKeeper, app.tibcKeeper.ClientKeeper,
-	)
-	app.mtTransferKeeper = tibcmttransferkeeper.NewKeeper(
-		appCodec, keys[tibcmttypes.StoreKey], app.GetSubspace(tibcmttypes.ModuleName),
-		app.accountKeeper, app.mtKeeper,
-		app.tibcKeeper.PacketKeeper, app.tibcKeeper.ClientKeeper,
-	)
-	nfttransferModule := tibcnfttransfer.NewAppModule(app.nftTransferKeeper)
-	mttransferModule := tibcmttransfer.NewAppModule(app.mtTransferKeeper)
-	tibcRouter := tibcroutingtypes.NewRouter()
-	tibcRouter.AddRoute(tibcnfttypes.ModuleName, nfttransferModule)
-	tibcRouter.AddRoute(tibcmttypes.ModuleName, mttransferModule)
-	app.tibcKeeper.SetRouter(tibcRouter)
+	// create the plugin keepers and modules
+	pluginModules := app.newPluginModules()
+
+	// seal the capability keeper once all the scoped keepers are created, the in-memory
+	// capabilities are regenerated from the store by the capability BeginBlocker
+	app.capabilityKeeper.Seal()
 
 	/****  Module Options ****/
 	var skipGenesisInvariants = false
@@ -418,12 +622,15 @@ func NewIritaApp(
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
-	app.mm = module.NewManager(
+	app.mm = module.NewManager(append([]module.AppModule{
 		genutil.NewAppModule(app.accountKeeper, app.nodeKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		capability.NewAppModule(appCodec, *app.capabilityKeeper),
+		perm.NewBankAppModule(appCodec, app.bankKeeper, app.accountKeeper),
 		crisis.NewAppModule(&app.crisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
+		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
 		cslashing.NewAppModule(appCodec, cslashing.NewKeeper(app.slashingKeeper, app.nodeKeeper), app.accountKeeper, app.bankKeeper, app.nodeKeeper),
 		upgrade.NewAppModule(app.upgradeKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
@@ -431,26 +638,22 @@ func NewIritaApp(
 		cparams.NewAppModule(appCodec, app.paramsKeeper),
 		token.NewAppModule(appCodec, app.tokenKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
-		mt.NewAppModule(appCodec, app.mtKeeper, app.accountKeeper, app.bankKeeper),
-		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
-		oracle.NewAppModule(appCodec, app.oracleKeeper, app.accountKeeper, app.bankKeeper),
-		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
 		identity.NewAppModule(app.identityKeeper),
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		node.NewAppModule(appCodec, app.nodeKeeper),
-		tibc.NewAppModule(app.tibcKeeper),
-		nfttransferModule,
-		mttransferModule,
-		// evm
-		evm.NewAppModule(app.EvmKeeper, app.accountKeeper),
-		feemarket.NewAppModule(app.FeeMarketKeeper),
-	)
+		perm.NewAppModule(app.permKeeper),
+		proposal.NewAppModule(app.proposalKeeper, app.accountKeeper),
+		ratelimit.NewAppModule(app.rateLimitKeeper),
+		msgfilter.NewAppModule(app.msgFilterKeeper),
+		sm2module.NewAppModule(app.sm2Keeper),
+	}, optionalModules...)...)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
+		capabilitytypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		authtypes.ModuleName,
@@ -463,20 +666,31 @@ func NewIritaApp(
 		tokentypes.ModuleName,
 		nfttypes.ModuleName,
 		mttypes.ModuleName,
+		coinswaptypes.ModuleName,
 		servicetypes.ModuleName,
 		oracletypes.ModuleName,
 		randomtypes.ModuleName,
 		identitytypes.ModuleName,
+		permtypes.ModuleName,
+		proposaltypes.ModuleName,
+		feeabstypes.ModuleName,
+		ratelimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		sm2types.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
+		authz.ModuleName,
+		vestingtypes.ModuleName,
 		tibchost.ModuleName,
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
+		fttransfertypes.ModuleName,
 
 		// evm
-		evmtypes.ModuleName, feemarkettypes.ModuleName,
+		evmtypes.ModuleName, feemarkettypes.ModuleName, erc20types.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
+		capabilitytypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		authtypes.ModuleName,
@@ -489,18 +703,28 @@ func NewIritaApp(
 		tokentypes.ModuleName,
 		nfttypes.ModuleName,
 		mttypes.ModuleName,
+		coinswaptypes.ModuleName,
 		servicetypes.ModuleName,
 		oracletypes.ModuleName,
 		randomtypes.ModuleName,
 		identitytypes.ModuleName,
+		permtypes.ModuleName,
+		proposaltypes.ModuleName,
+		feeabstypes.ModuleName,
+		ratelimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		sm2types.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
+		authz.ModuleName,
+		vestingtypes.ModuleName,
 		tibchost.ModuleName,
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
+		fttransfertypes.ModuleName,
 
 		// evm
-		evmtypes.ModuleName, feemarkettypes.ModuleName,
+		evmtypes.ModuleName, feemarkettypes.ModuleName, erc20types.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -509,6 +733,7 @@ func NewIritaApp(
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
 	app.mm.SetOrderInitGenesis(
+		capabilitytypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		authtypes.ModuleName,
@@ -521,26 +746,32 @@ func NewIritaApp(
 		tokentypes.ModuleName,
 		nfttypes.ModuleName,
 		mttypes.ModuleName,
+		coinswaptypes.ModuleName,
 		servicetypes.ModuleName,
 		oracletypes.ModuleName,
 		randomtypes.ModuleName,
 		identitytypes.ModuleName,
+		permtypes.ModuleName,
+		proposaltypes.ModuleName,
+		feeabstypes.ModuleName,
+		ratelimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		sm2types.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
+		authz.ModuleName,
+		vestingtypes.ModuleName,
 		tibchost.ModuleName,
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
+		fttransfertypes.ModuleName,
 
 		// evm
-		evmtypes.ModuleName, feemarkettypes.ModuleName,
+		evmtypes.ModuleName, feemarkettypes.ModuleName, erc20types.ModuleName,
 	)
 
-	// extend Modules
-	if appOptions.addModule != nil {
-		appOptions.addModule(app, app.mm, app.keys)
-	}
-
 	app.mm.SetOrderMigrations(
+		capabilitytypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		authtypes.ModuleName,
@@ -553,20 +784,34 @@ func NewIritaApp(
 		tokentypes.ModuleName,
 		nfttypes.ModuleName,
 		mttypes.ModuleName,
+		coinswaptypes.ModuleName,
 		servicetypes.ModuleName,
 		oracletypes.ModuleName,
 		randomtypes.ModuleName,
 		identitytypes.ModuleName,
+		permtypes.ModuleName,
+		proposaltypes.ModuleName,
+		feeabstypes.ModuleName,
+		ratelimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		sm2types.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
+		authz.ModuleName,
+		vestingtypes.ModuleName,
 		tibchost.ModuleName,
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
+		fttransfertypes.ModuleName,
 
 		// evm
-		evmtypes.ModuleName, feemarkettypes.ModuleName,
+		evmtypes.ModuleName, feemarkettypes.ModuleName, erc20types.ModuleName,
 	)
 
+	// remove the disabled modules and add the plugin modules to the module manager orders
+	app.removeDisabledModules()
+	app.addPluginModules(pluginModules)
+
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -578,28 +823,24 @@ func NewIritaApp(
 	// transactions
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		capability.NewAppModule(appCodec, *app.capabilityKeeper),
+		perm.NewBankAppModule(appCodec, app.bankKeeper, app.accountKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
+		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
 		cslashing.NewAppModule(appCodec, cslashing.NewKeeper(app.slashingKeeper, app.nodeKeeper), app.accountKeeper, app.bankKeeper, app.nodeKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		cparams.NewAppModule(appCodec, app.paramsKeeper),
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		token.NewAppModule(appCodec, app.tokenKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
-		mt.NewAppModule(appCodec, app.mtKeeper, app.accountKeeper, app.bankKeeper),
-		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
-		oracle.NewAppModule(appCodec, app.oracleKeeper, app.accountKeeper, app.bankKeeper),
-		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
 		identity.NewAppModule(app.identityKeeper),
 		node.NewAppModule(appCodec, app.nodeKeeper),
-		tibc.NewAppModule(app.tibcKeeper),
-		nfttransferModule,
-		mttransferModule,
-		// evm
-		evm.NewAppModule(app.EvmKeeper, app.accountKeeper),
-		feemarket.NewAppModule(app.FeeMarketKeeper),
 	)
 
+	app.addSimulationModules(optionalModules)
+	app.addSimulationModules(pluginModules)
+
 	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
@@ -607,6 +848,18 @@ func NewIritaApp(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	if err := app.setStreamer(appOpts, homePath); err != nil {
+		tmos.Exit(err.Error())
+	}
+	if err := app.setIndexer(appOpts, homePath); err != nil {
+		tmos.Exit(err.Error())
+	}
+	rateLimiter, err := ratelimit.LimiterFromAppOptions(appOpts)
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+	app.rateLimiter = rateLimiter
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -614,15 +867,8 @@ func NewIritaApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	// Set software upgrade execution logic
-	// app.RegisterUpgradePlan("add-record-module",
-	// 	store.StoreUpgrades{
-	// 		Added: []string{recordtypes.StoreKey},
-	// 	},
-	// 	func(ctx sdk.Context, plan sdkupgrade.Plan) {},
-	// )
-	if appOptions.upgradePlan != nil {
-		appOptions.upgradePlan(app, app.configurator, app.mm)
-	}
+	app.registerUpgrades()
+	app.registerPluginUpgradePlans()
 
 	// set peer filter by node ID
 	app.SetIDPeerFilter(app.nodeKeeper.FilterNodeByID)
@@ -631,16 +877,7 @@ func NewIritaApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
-
-		// Initialize and seal the capability keeper so all persistent capabilities
-		// are loaded in-memory and prevent any further modules from creating scoped
-		// sub-keepers.
-		// This must be done during creation of baseapp rather than in InitChain so
-		// that in-memory capabilities get regenerated on app restart.
-		// Note that since this reads from the store, we can only perform it when
-		// `loadLatest` is set to true.
-		//ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
-		//app.capabilityKeeper.InitializeAndSeal(ctx)
+		app.loadEvmSigner()
 	}
 	return app
 }
@@ -650,11 +887,31 @@ func (app *IritaApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *IritaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	chainID, _ := ethermint.ParseChainID(req.GetHeader().ChainID)
-	if app.EvmKeeper.Signer == nil {
-		app.EvmKeeper.Signer = crypto.NewSm2Signer(chainID)
+	res := app.mm.BeginBlock(ctx, req)
+
+	// the SM2 signer hashes the txs of the block with the algorithm read by the sm2 module
+	// begin blocker, and is only replaced once the algorithm changes
+	if app.EvmKeeper != nil {
+		chainID, _ := ethermint.ParseChainID(req.GetHeader().ChainID)
+		signer := crypto.NewSm2Signer(chainID, app.sm2Keeper.EvmTxHashAlgo(ctx))
+		if app.EvmKeeper.Signer == nil || !signer.Equal(app.EvmKeeper.Signer) {
+			app.EvmKeeper.Signer = signer
+		}
 	}
-	return app.mm.BeginBlock(ctx, req)
+	return res
+}
+
+// loadEvmSigner sets the SM2 signer from the committed params once the app is loaded, as
+// the queries and the simulations served before the first begin blocker use it
+func (app *IritaApp) loadEvmSigner() {
+	if app.EvmKeeper == nil {
+		return
+	}
+
+	// the EVM chain ID is the configured ethermint.EvmChainID rather than the node chain ID
+	chainID, _ := ethermint.ParseChainID(ethermint.EvmChainID)
+	ctx := app.NewUncachedContext(false, tmproto.Header{})
+	app.EvmKeeper.Signer = crypto.NewSm2Signer(chainID, app.sm2Keeper.EvmTxHashAlgo(ctx))
 }
 
 // EndBlocker application updates every end block
@@ -666,20 +923,41 @@ func (app *IritaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 func (app *IritaApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+	if err := app.modules.checkGenesis(genesisState); err != nil {
+		panic(err)
+	}
 
-	// add system service at InitChainer, overwrite if it exists
+	if app.EvmKeeper != nil {
+		chainID, _ := ethermint.ParseChainID(req.ChainId)
+		app.EvmKeeper.Signer = crypto.NewSm2Signer(chainID, app.sm2Keeper.EvmTxHashAlgo(ctx))
+	}
+
+	app.upgradeKeeper.UpgradeKeeper().SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+
+	if streamed, ok := genesisState[StreamedAppStateKey]; ok {
+		res, err := app.initGenesisFromFile(ctx, streamed)
+		if err != nil {
+			panic(err)
+		}
+		return res
+	}
+
+	if app.modules.IsEnabled(servicetypes.ModuleName) {
+		genesisState[servicetypes.ModuleName] = app.addSystemServices(genesisState[servicetypes.ModuleName])
+	}
+	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+}
+
+// addSystemServices adds the system services to the service genesis state,
+// overwriting them if they exist
+func (app *IritaApp) addSystemServices(bz json.RawMessage) json.RawMessage {
 	var serviceGenState servicetypes.GenesisState
-	app.appCodec.MustUnmarshalJSON(genesisState[servicetypes.ModuleName], &serviceGenState)
-	//req.ChainId
+	app.appCodec.MustUnmarshalJSON(bz, &serviceGenState)
 
-	chainID, _ := ethermint.ParseChainID(req.ChainId)
-	app.EvmKeeper.Signer = crypto.NewSm2Signer(chainID)
 	serviceGenState.Definitions = append(serviceGenState.Definitions, servicetypes.GenOraclePriceSvcDefinition())
 	serviceGenState.Bindings = append(serviceGenState.Bindings, servicetypes.GenOraclePriceSvcBinding(tokentypes.GetNativeToken().MinUnit))
 	serviceGenState.Definitions = append(serviceGenState.Definitions, randomtypes.GetSvcDefinition())
-	genesisState[servicetypes.ModuleName] = app.appCodec.MustMarshalJSON(&serviceGenState)
-
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	return app.appCodec.MustMarshalJSON(&serviceGenState)
 }
 
 // LoadHeight loads a particular height
@@ -695,7 +973,7 @@ func (app *IritaApp) RegisterTendermintService(clientCtx client.Context) {
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *IritaApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
+	for acc := range app.maccPerms {
 		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
@@ -765,16 +1043,27 @@ func (app *IritaApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 	authrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
 
 	// evm
-	evmrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
+	if app.modules.IsEnabled(evmtypes.ModuleName) {
+		evmrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
+	}
 
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register new tx routes from grpc-gateway.
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
-	// Register legacy and grpc-gateway routes for all modules.
-	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
-	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register legacy and grpc-gateway routes for all the enabled modules.
+	moduleBasics := app.modules.moduleBasics(ModuleBasics)
+	moduleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+	moduleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register the indexer query routes.
+	if app.indexer != nil {
+		indexer.RegisterRoutes(apiSvr.Router, app.indexer)
+	}
+
+	// Register the plugin routes.
+	registerPluginAPIRoutes(apiSvr, apiConfig)
 
 	if apiConfig.Swagger {
 		lite.RegisterSwaggerAPI(clientCtx, apiSvr.Router)
@@ -812,15 +1101,29 @@ func (app *IritaApp) BuildAnteHandler(encodingConfig simappparams.EncodingConfig
 		FeegrantKeeper:  app.feeGrantKeeper,
 		SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:  ethermintante.DefaultSigVerificationGasConsumer,
+		PermKeeper:      app.permKeeper,
+		RateLimitKeeper: app.rateLimitKeeper,
+		RateLimiter:     app.rateLimiter,
+		MsgFilterKeeper: app.msgFilterKeeper,
+		Sm2Keeper:       app.sm2Keeper,
 
 		// evm
-		EvmFeeMarketKeeper: app.FeeMarketKeeper,
-		EvmKeeper:          app.EvmKeeper,
+		EvmFeeMarketKeeper:  app.FeeMarketKeeper,
+		ContractCallable:    app.permKeeper,
+		ContractDeployable:  app.permKeeper,
+		ContractSponsorable: app.permKeeper,
 	}
-
-	if appOptions.anteHandler != nil {
-		return appOptions.anteHandler(app, handlerOptions)
+	// the ethereum txs are rejected when the evm module is disabled
+	if app.EvmKeeper != nil {
+		handlerOptions.EvmKeeper = app.EvmKeeper
+	}
+	// the fees are only paid in the fee tokens when the feeabs module is enabled
+	if app.modules.IsEnabled(feeabstypes.ModuleName) {
+		handlerOptions.FeeAbsKeeper = &app.feeAbsKeeper
 	}
+
+	handlerOptions.ExtraDecorators = app.pluginAnteDecorators(handlerOptions)
+
 	return appante.NewAnteHandler(handlerOptions)
 }
 
@@ -848,13 +1151,26 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(tokentypes.ModuleName)
+	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(recordtypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
 	paramsKeeper.Subspace(tibchost.ModuleName)
+	paramsKeeper.Subspace(proposaltypes.ModuleName)
+	paramsKeeper.Subspace(feeabstypes.ModuleName)
+	paramsKeeper.Subspace(ratelimittypes.ModuleName)
+	paramsKeeper.Subspace(msgfiltertypes.ModuleName)
+	paramsKeeper.Subspace(sm2types.ModuleName)
 
 	// evm
 	paramsKeeper.Subspace(evmtypes.ModuleName)
 	paramsKeeper.Subspace(feemarkettypes.ModuleName)
 
+	// plugins
+	for _, p := range plugins {
+		for _, subspace := range p.Subspaces {
+			paramsKeeper.Subspace(subspace)
+		}
+	}
+
 	return paramsKeeper
 }
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
@@ -14,9 +16,14 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	ethermint "github.com/tharsis/ethermint/types"
 
 	"github.com/bianjieai/iritamod/modules/node"
+
+	evmcrypto "github.com/bianjieai/irita/modules/evm/crypto"
+	sm2types "github.com/bianjieai/irita/modules/sm2/types"
 )
 
 var (
@@ -91,6 +98,10 @@ func setGenesis(iapp *IritaApp) error {
 	tokenGenState.Tokens = append(tokenGenState.Tokens, pointToken)
 	genesisState[tokentypes.ModuleName] = iapp.appCodec.MustMarshalJSON(&tokenGenState)
 
+	if err := iapp.modules.setGenesis(genesisState); err != nil {
+		return err
+	}
+
 	stateBytes, err := codec.MarshalJSONIndent(iapp.cdc, genesisState)
 	if err != nil {
 		return err
@@ -106,3 +117,48 @@ func setGenesis(iapp *IritaApp) error {
 	iapp.Commit()
 	return nil
 }
+
+// createAccount commits a block creating the account of the key
+func createAccount(iapp *IritaApp, priv cryptotypes.PrivKey) authtypes.AccountI {
+	header := tmproto.Header{Height: iapp.LastBlockHeight() + 1, ChainID: "irita_1000-1"}
+	iapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := iapp.BaseApp.NewContext(false, header)
+	account := iapp.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(priv.PubKey().Address()))
+	iapp.accountKeeper.SetAccount(ctx, account)
+
+	iapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	iapp.Commit()
+	return account
+}
+
+// signTx returns the encoded tx of the msgs signed with the key for the given chain
+func signTx(t *testing.T, chainID string, priv cryptotypes.PrivKey, accountNumber, sequence uint64, msgs ...sdk.Msg) []byte {
+	txConfig := MakeEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txConfig, msgs, sdk.NewCoins(), helpers.DefaultGenTxGas, chainID,
+		[]uint64{accountNumber}, []uint64{sequence}, priv,
+	)
+	require.NoError(t, err)
+
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestLoadEvmSigner(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIritaApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), mapAppOptions{})
+	require.NoError(t, setGenesis(app))
+
+	// commit the SM3 hash of the SM2 signed txs
+	ctx := app.NewUncachedContext(false, tmproto.Header{})
+	app.sm2Keeper.SetParams(ctx, sm2types.NewParams(evmcrypto.HashSM3))
+	ctx.MultiStore().(sdk.CommitMultiStore).Commit()
+
+	// the signer of the restarted app is set before the first begin blocker
+	app = NewIritaApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), mapAppOptions{})
+	chainID, err := ethermint.ParseChainID("irita_1000-1")
+	require.NoError(t, err)
+	require.True(t, evmcrypto.NewSm2Signer(chainID, evmcrypto.HashSM3).Equal(app.EvmKeeper.Signer))
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestAuthzExecNFT(t *testing.T) {
+	// the fee market BeginBlocker requires the consensus params
+	appOpts := mapAppOptions{FlagDisabledModules: []string{"evm"}}
+	app := NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), appOpts)
+	require.NoError(t, setGenesis(app))
+
+	granterKey, granteeKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	granter, grantee := createAccount(app, granterKey), createAccount(app, granteeKey)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, ChainID: "irita_1000-1", Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the granter lets the grantee issue the nft denoms on its behalf
+	issueDenom := nfttypes.NewMsgIssueDenom(
+		"granted", "granted", "", granter.GetAddress().String(), "grt", false, false, "", "", "", "",
+	)
+	grant, err := authz.NewMsgGrant(
+		granter.GetAddress(), grantee.GetAddress(),
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(issueDenom)), header.Time.Add(time.Hour),
+	)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{
+		Tx: signTx(t, header.ChainID, granterKey, granter.GetAccountNumber(), granter.GetSequence(), grant),
+	})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+
+	exec := authz.NewMsgExec(grantee.GetAddress(), []sdk.Msg{issueDenom})
+	res = app.DeliverTx(abci.RequestDeliverTx{
+		Tx: signTx(t, header.ChainID, granteeKey, grantee.GetAccountNumber(), grantee.GetSequence(), &exec),
+	})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+
+	// an ungranted message is rejected
+	mint := nfttypes.NewMsgMintNFT(
+		"token", "granted", "", "", "", "", granter.GetAddress().String(), grantee.GetAddress().String(),
+	)
+	exec = authz.NewMsgExec(grantee.GetAddress(), []sdk.Msg{mint})
+	res = app.DeliverTx(abci.RequestDeliverTx{
+		Tx: signTx(t, header.ChainID, granteeKey, grantee.GetAccountNumber(), grantee.GetSequence()+1, &exec),
+	})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the denom is issued by the granter
+	ctx := app.BaseApp.NewContext(true, header)
+	denom, found := app.nftKeeper.GetDenom(ctx, "granted")
+	require.True(t, found)
+	require.Equal(t, granter.GetAddress().String(), denom.Creator)
+}
@@ -6,6 +6,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/irisnet/irismod/modules/service"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/bianjieai/iritamod/modules/node"
@@ -13,6 +14,22 @@ import (
 
 // ExportAppStateAndValidators export the state of irita for a genesis file
 func (app *IritaApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs []string) (servertypes.ExportedApp, error) {
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
+
+	genState := app.mm.ExportGenesis(ctx, app.appCodec)
+	if err := app.modules.setGenesis(genState); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	return app.exportedApp(ctx, height, appState), nil
+}
+
+// exportContext returns the context and the height of the export, preparing the
+// state for a zero height genesis if needed
+func (app *IritaApp) exportContext(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, int64) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 
@@ -21,20 +38,17 @@ func (app *IritaApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowed
 		height = 0
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
+	return ctx, height
+}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
-	}
-
+func (app *IritaApp) exportedApp(ctx sdk.Context, height int64, appState json.RawMessage) servertypes.ExportedApp {
 	validators := node.WriteValidators(ctx, app.nodeKeeper)
 	return servertypes.ExportedApp{
 		AppState:        appState,
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
-	}, nil
+	}
 }
 
 // prepare for fresh start at zero height
@@ -46,5 +60,7 @@ func (app *IritaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 	/* Just to be safe, assert the invariants on current state. */
 	app.crisisKeeper.AssertInvariants(ctx)
 
-	service.PrepForZeroHeightGenesis(ctx, app.serviceKeeper)
+	if app.modules.IsEnabled(servicetypes.ModuleName) {
+		service.PrepForZeroHeightGenesis(ctx, app.serviceKeeper)
+	}
 }
@@ -0,0 +1,28 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/bianjieai/irita/app/indexer"
+)
+
+// setIndexer opens the database of the configured indexer, if any
+func (app *IritaApp) setIndexer(appOpts servertypes.AppOptions, homePath string) error {
+	config, err := indexer.ConfigFromAppOptions(appOpts, homePath)
+	if err != nil || len(config.Driver) == 0 {
+		return err
+	}
+
+	idx, err := indexer.Open(config.Driver, config.DSN, app.interfaceRegistry)
+	if err != nil {
+		return err
+	}
+	app.indexer = idx
+
+	height, err := idx.LastHeight()
+	if err != nil {
+		return err
+	}
+	app.Logger().Info("indexing the txs", "driver", config.Driver, "last-height", height)
+	return nil
+}
@@ -0,0 +1,61 @@
+package indexer
+
+import (
+	"fmt"
+	"path/filepath"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// The app.toml options of the `[indexer]` section
+const (
+	FlagDriver = "indexer.driver"
+	FlagDSN    = "indexer.dsn"
+)
+
+// The supported drivers
+const (
+	DriverSQLite   = "sqlite3"
+	DriverPostgres = "postgres"
+)
+
+const defaultSQLiteFile = "data/indexer.db"
+
+// AddFlags adds the indexer flags to the start command
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagDriver, "", fmt.Sprintf("Database driver of the tx indexer, %s or %s, disabled if empty", DriverSQLite, DriverPostgres))
+	startCmd.Flags().String(FlagDSN, "", fmt.Sprintf("Data source name of the indexer database, %s under the node home by default with %s", defaultSQLiteFile, DriverSQLite))
+}
+
+// Config is the indexer configuration
+type Config struct {
+	Driver string
+	DSN    string
+}
+
+// ConfigFromAppOptions reads the indexer configuration from the app options,
+// defaulting the SQLite database to a file under the node home
+func ConfigFromAppOptions(appOpts servertypes.AppOptions, homePath string) (Config, error) {
+	config := Config{
+		Driver: cast.ToString(appOpts.Get(FlagDriver)),
+		DSN:    cast.ToString(appOpts.Get(FlagDSN)),
+	}
+
+	switch config.Driver {
+	case "":
+	case DriverSQLite:
+		if len(config.DSN) == 0 {
+			// WAL lets the API server read while the blocks are indexed
+			config.DSN = fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL", filepath.Join(homePath, defaultSQLiteFile))
+		}
+	case DriverPostgres:
+		if len(config.DSN) == 0 {
+			return config, fmt.Errorf("the %s option is required by the %s driver", FlagDSN, DriverPostgres)
+		}
+	default:
+		return config, fmt.Errorf("unknown indexer driver %s, expected %s or %s", config.Driver, DriverSQLite, DriverPostgres)
+	}
+	return config, nil
+}
@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	mttypes "github.com/irisnet/irismod/modules/mt/types"
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	identitytypes "github.com/bianjieai/iritamod/modules/identity/types"
+)
+
+// row is a row to insert into a table
+type row struct {
+	table  string
+	values map[string]interface{}
+}
+
+// txContext holds what the rows of a successful tx are decoded from
+type txContext struct {
+	height  int64
+	txIndex int
+	txHash  string
+
+	// responses are the msg responses, in the order of the msgs
+	responses []*sdk.MsgData
+	// events are the values of the event attributes, by event type and key, consumed
+	// in order by the msgs which generate their ids
+	events map[string]map[string][]string
+}
+
+func newTxContext(height int64, txIndex int, txHash string, res abci.ResponseDeliverTx) *txContext {
+	tc := &txContext{
+		height:  height,
+		txIndex: txIndex,
+		txHash:  txHash,
+		events:  make(map[string]map[string][]string),
+	}
+
+	var msgData sdk.TxMsgData
+	if err := proto.Unmarshal(res.Data, &msgData); err == nil {
+		tc.responses = msgData.Data
+	}
+
+	for _, event := range res.Events {
+		attrs, ok := tc.events[event.Type]
+		if !ok {
+			attrs = make(map[string][]string)
+			tc.events[event.Type] = attrs
+		}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = append(attrs[string(attr.Key)], string(attr.Value))
+		}
+	}
+	return tc
+}
+
+// nextEvent consumes the next value of the given event attribute
+func (tc *txContext) nextEvent(eventType, key string) string {
+	values := tc.events[eventType][key]
+	if len(values) == 0 {
+		return ""
+	}
+	tc.events[eventType][key] = values[1:]
+	return values[0]
+}
+
+// response decodes the response of the msg at the given index
+func (tc *txContext) response(msgIndex int, res proto.Message) bool {
+	if msgIndex >= len(tc.responses) {
+		return false
+	}
+	return proto.Unmarshal(tc.responses[msgIndex].Data, res) == nil
+}
+
+// msgRow returns a row of a msg table with the given values and sender
+func (tc *txContext) msgRow(table string, msgIndex int, msg sdk.Msg, sender string, values map[string]interface{}) row {
+	values["height"] = tc.height
+	values["tx_index"] = tc.txIndex
+	values["tx_hash"] = tc.txHash
+	values["msg_index"] = msgIndex
+	values["msg_type"] = sdk.MsgTypeURL(msg)
+	values["sender"] = sender
+	return row{table: table, values: values}
+}
+
+// decodeMsg returns the rows indexing the msg, if it belongs to an indexed module
+func (tc *txContext) decodeMsg(msgIndex int, msg sdk.Msg) []row {
+	switch msg := msg.(type) {
+	// nft
+	case *nfttypes.MsgIssueDenom:
+		tc.nextEvent(nfttypes.EventTypeIssueDenom, nfttypes.AttributeKeyDenomID)
+		return []row{tc.msgRow(TableNFT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.Id})}
+	case *nfttypes.MsgTransferDenom:
+		return []row{tc.msgRow(TableNFT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.Id, "recipient": msg.Recipient})}
+	case *nfttypes.MsgMintNFT:
+		return []row{tc.msgRow(TableNFT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.DenomId, "nft_id": msg.Id, "recipient": msg.Recipient})}
+	case *nfttypes.MsgTransferNFT:
+		return []row{tc.msgRow(TableNFT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.DenomId, "nft_id": msg.Id, "recipient": msg.Recipient})}
+	case *nfttypes.MsgEditNFT:
+		return []row{tc.msgRow(TableNFT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.DenomId, "nft_id": msg.Id})}
+	case *nfttypes.MsgBurnNFT:
+		return []row{tc.msgRow(TableNFT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.DenomId, "nft_id": msg.Id})}
+
+	// mt, whose denom and token ids are generated by the chain
+	case *mttypes.MsgIssueDenom:
+		denomID := tc.nextEvent(mttypes.EventTypeIssueDenom, mttypes.AttributeKeyDenomID)
+		return []row{tc.msgRow(TableMT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": denomID})}
+	case *mttypes.MsgTransferDenom:
+		return []row{tc.msgRow(TableMT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.Id, "recipient": msg.Recipient})}
+	case *mttypes.MsgMintMT:
+		mtID := tc.nextEvent(mttypes.EventTypeMintMT, mttypes.AttributeKeyMTID)
+		return []row{tc.msgRow(TableMT, msgIndex, msg, msg.Sender, map[string]interface{}{
+			"denom_id": msg.DenomId, "mt_id": mtID, "recipient": msg.Recipient, "amount": formatUint(msg.Amount),
+		})}
+	case *mttypes.MsgTransferMT:
+		return []row{tc.msgRow(TableMT, msgIndex, msg, msg.Sender, map[string]interface{}{
+			"denom_id": msg.DenomId, "mt_id": msg.Id, "recipient": msg.Recipient, "amount": formatUint(msg.Amount),
+		})}
+	case *mttypes.MsgEditMT:
+		return []row{tc.msgRow(TableMT, msgIndex, msg, msg.Sender, map[string]interface{}{"denom_id": msg.DenomId, "mt_id": msg.Id})}
+	case *mttypes.MsgBurnMT:
+		return []row{tc.msgRow(TableMT, msgIndex, msg, msg.Sender, map[string]interface{}{
+			"denom_id": msg.DenomId, "mt_id": msg.Id, "amount": formatUint(msg.Amount),
+		})}
+
+	// token
+	case *tokentypes.MsgIssueToken:
+		return []row{tc.msgRow(TableToken, msgIndex, msg, msg.Owner, map[string]interface{}{
+			"symbol": msg.Symbol, "recipient": msg.Owner, "amount": formatUint(msg.InitialSupply),
+		})}
+	case *tokentypes.MsgEditToken:
+		return []row{tc.msgRow(TableToken, msgIndex, msg, msg.Owner, map[string]interface{}{"symbol": msg.Symbol})}
+	case *tokentypes.MsgMintToken:
+		recipient := msg.To
+		if len(recipient) == 0 {
+			recipient = msg.Owner
+		}
+		return []row{tc.msgRow(TableToken, msgIndex, msg, msg.Owner, map[string]interface{}{
+			"symbol": msg.Symbol, "recipient": recipient, "amount": formatUint(msg.Amount),
+		})}
+	case *tokentypes.MsgBurnToken:
+		return []row{tc.msgRow(TableToken, msgIndex, msg, msg.Sender, map[string]interface{}{
+			"symbol": msg.Symbol, "amount": formatUint(msg.Amount),
+		})}
+	case *tokentypes.MsgTransferTokenOwner:
+		return []row{tc.msgRow(TableToken, msgIndex, msg, msg.SrcOwner, map[string]interface{}{"symbol": msg.Symbol, "recipient": msg.DstOwner})}
+
+	// record
+	case *recordtypes.MsgCreateRecord:
+		var res recordtypes.MsgCreateRecordResponse
+		tc.response(msgIndex, &res)
+		return []row{tc.msgRow(TableRecord, msgIndex, msg, msg.Creator, map[string]interface{}{"record_id": res.Id})}
+
+	// identity
+	case *identitytypes.MsgCreateIdentity:
+		return []row{tc.msgRow(TableIdentity, msgIndex, msg, msg.Owner, map[string]interface{}{"identity_id": msg.Id})}
+	case *identitytypes.MsgUpdateIdentity:
+		return []row{tc.msgRow(TableIdentity, msgIndex, msg, msg.Owner, map[string]interface{}{"identity_id": msg.Id})}
+
+	// service
+	case *servicetypes.MsgDefineService:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Author, map[string]interface{}{"service_name": msg.Name})}
+	case *servicetypes.MsgBindService:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{"service_name": msg.ServiceName, "provider": msg.Provider})}
+	case *servicetypes.MsgUpdateServiceBinding:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{"service_name": msg.ServiceName, "provider": msg.Provider})}
+	case *servicetypes.MsgDisableServiceBinding:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{"service_name": msg.ServiceName, "provider": msg.Provider})}
+	case *servicetypes.MsgEnableServiceBinding:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{"service_name": msg.ServiceName, "provider": msg.Provider})}
+	case *servicetypes.MsgRefundServiceDeposit:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{"service_name": msg.ServiceName, "provider": msg.Provider})}
+	case *servicetypes.MsgSetWithdrawAddress:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{})}
+	case *servicetypes.MsgWithdrawEarnedFees:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Owner, map[string]interface{}{"provider": msg.Provider})}
+	case *servicetypes.MsgCallService:
+		var res servicetypes.MsgCallServiceResponse
+		tc.response(msgIndex, &res)
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Consumer, map[string]interface{}{
+			"service_name": msg.ServiceName, "request_context_id": res.RequestContextId,
+		})}
+	case *servicetypes.MsgRespondService:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Provider, map[string]interface{}{"provider": msg.Provider, "request_id": msg.RequestId})}
+	case *servicetypes.MsgPauseRequestContext:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Consumer, map[string]interface{}{"request_context_id": msg.RequestContextId})}
+	case *servicetypes.MsgStartRequestContext:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Consumer, map[string]interface{}{"request_context_id": msg.RequestContextId})}
+	case *servicetypes.MsgKillRequestContext:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Consumer, map[string]interface{}{"request_context_id": msg.RequestContextId})}
+	case *servicetypes.MsgUpdateRequestContext:
+		return []row{tc.msgRow(TableService, msgIndex, msg, msg.Consumer, map[string]interface{}{"request_context_id": msg.RequestContextId})}
+	}
+	return nil
+}
+
+// decodeEVMLogs returns the rows of the logs emitted by the ethereum txs
+func (tc *txContext) decodeEVMLogs() []row {
+	var rows []row
+	for _, value := range tc.events[evmtypes.EventTypeTxLog][evmtypes.AttributeKeyTxLog] {
+		var log evmtypes.Log
+		if err := json.Unmarshal([]byte(value), &log); err != nil {
+			continue
+		}
+
+		values := map[string]interface{}{
+			"height":      tc.height,
+			"tx_index":    tc.txIndex,
+			"tx_hash":     tc.txHash,
+			"eth_tx_hash": log.TxHash,
+			"log_index":   int64(log.Index),
+			"address":     log.Address,
+			"data":        "0x" + hex.EncodeToString(log.Data),
+		}
+		for i, topic := range log.Topics {
+			if i < 4 {
+				values["topic"+strconv.Itoa(i)] = topic
+			}
+		}
+		rows = append(rows, row{table: TableEVMLogs, values: values})
+	}
+	return rows
+}
+
+func formatUint(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
@@ -0,0 +1,292 @@
+// Package indexer indexes the committed txs into a SQL database, with typed rows for
+// the messages of the nft, mt, token, record, identity and service modules and for
+// the EVM logs, and serves them through the API server.
+//
+// The schema is compatible with SQLite, for local use, and PostgreSQL.
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	// the supported database drivers
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	defaultQueryLimit = 100
+	maxQueryLimit     = 1000
+)
+
+// Indexer indexes the txs of the blocks once committed
+type Indexer struct {
+	db        *sql.DB
+	txDecoder sdk.TxDecoder
+
+	// the block being executed
+	height int64
+	time   time.Time
+	numTxs int
+	rows   []row
+}
+
+// Open opens the database, creating the tables if needed. The txs are decoded with
+// the interfaces of the given registry.
+func Open(driver, dsn string, registry codectypes.InterfaceRegistry) (*Indexer, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tables {
+		for _, statement := range t.createStatements() {
+			if _, err := db.Exec(statement); err != nil {
+				_ = db.Close()
+				return nil, fmt.Errorf("failed to create the %s table: %w", t.name, err)
+			}
+		}
+	}
+
+	return &Indexer{
+		db:        db,
+		txDecoder: authtx.DefaultTxDecoder(codec.NewProtoCodec(registry)),
+	}, nil
+}
+
+// Close closes the database
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
+
+// LastHeight returns the height of the last indexed block, 0 if none
+func (idx *Indexer) LastHeight() (int64, error) {
+	var height sql.NullInt64
+	if err := idx.db.QueryRow(fmt.Sprintf("SELECT MAX(height) FROM %s", TableBlocks)).Scan(&height); err != nil {
+		return 0, err
+	}
+	return height.Int64, nil
+}
+
+// ListenBeginBlock starts indexing a block
+func (idx *Indexer) ListenBeginBlock(req abci.RequestBeginBlock) {
+	idx.height = req.Header.Height
+	idx.time = req.Header.Time
+	idx.numTxs = 0
+	idx.rows = nil
+}
+
+// ListenDeliverTx indexes a tx of the block, and its messages and EVM logs if it
+// succeeded
+func (idx *Indexer) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	txIndex := idx.numTxs
+	idx.numTxs++
+
+	txHash := fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash())
+	idx.rows = append(idx.rows, row{
+		table: TableTxs,
+		values: map[string]interface{}{
+			"height":     idx.height,
+			"tx_index":   txIndex,
+			"hash":       txHash,
+			"code":       int64(res.Code),
+			"codespace":  res.Codespace,
+			"gas_wanted": res.GasWanted,
+			"gas_used":   res.GasUsed,
+			"log":        res.Log,
+		},
+	})
+	if !res.IsOK() {
+		return
+	}
+
+	tx, err := idx.txDecoder(req.Tx)
+	if err != nil {
+		return
+	}
+
+	tc := newTxContext(idx.height, txIndex, txHash, res)
+	for i, msg := range tx.GetMsgs() {
+		idx.rows = append(idx.rows, tc.decodeMsg(i, msg)...)
+	}
+	idx.rows = append(idx.rows, tc.decodeEVMLogs()...)
+}
+
+// ListenCommit writes the rows of the block, replacing those of a previous run
+func (idx *Indexer) ListenCommit() error {
+	// the genesis state is committed without BeginBlock
+	if idx.height == 0 {
+		return nil
+	}
+	defer func() {
+		idx.height = 0
+		idx.rows = nil
+	}()
+
+	dbTx, err := idx.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := idx.writeBlock(dbTx); err != nil {
+		_ = dbTx.Rollback()
+		return fmt.Errorf("failed to index the block %d: %w", idx.height, err)
+	}
+	return dbTx.Commit()
+}
+
+func (idx *Indexer) writeBlock(dbTx *sql.Tx) error {
+	for _, t := range tables {
+		if _, err := dbTx.Exec(fmt.Sprintf("DELETE FROM %s WHERE height = $1", t.name), idx.height); err != nil {
+			return err
+		}
+	}
+
+	if err := insert(dbTx, row{
+		table: TableBlocks,
+		values: map[string]interface{}{
+			"height":  idx.height,
+			"time":    idx.time.UTC().Format(time.RFC3339Nano),
+			"num_txs": idx.numTxs,
+		},
+	}); err != nil {
+		return err
+	}
+
+	for _, r := range idx.rows {
+		if err := insert(dbTx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert inserts the row, the missing values being NULL
+func insert(dbTx *sql.Tx, r row) error {
+	t, ok := getTable(r.table)
+	if !ok {
+		return fmt.Errorf("unknown table %s", r.table)
+	}
+
+	args := make([]interface{}, len(t.columns))
+	for i, c := range t.columns {
+		args[i] = r.values[c.name]
+	}
+	_, err := dbTx.Exec(t.insertStatement(), args...)
+	return err
+}
+
+// Query selects rows of a table
+type Query struct {
+	Table string
+	// Filters are the column values the rows must be equal to
+	Filters    map[string]string
+	FromHeight int64
+	ToHeight   int64
+	Limit      int
+	Offset     int
+}
+
+// Query returns the rows matching the query, ordered by height
+func (idx *Indexer) Query(q Query) ([]map[string]interface{}, error) {
+	t, ok := getTable(q.Table)
+	if !ok {
+		return nil, fmt.Errorf("unknown table %s", q.Table)
+	}
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	for name, value := range q.Filters {
+		c, ok := t.column(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %s of the table %s", name, t.name)
+		}
+		if c.kind == kindText {
+			addCondition(name+" = $%d", value)
+			continue
+		}
+
+		i, err := parseInt(name, value)
+		if err != nil {
+			return nil, err
+		}
+		addCondition(name+" = $%d", i)
+	}
+	if q.FromHeight > 0 {
+		addCondition("height >= $%d", q.FromHeight)
+	}
+	if q.ToHeight > 0 {
+		addCondition("height <= $%d", q.ToHeight)
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	if limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+
+	names := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		names[i] = c.name
+	}
+	statement := fmt.Sprintf("SELECT %s FROM %s", strings.Join(names, ", "), t.name)
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += fmt.Sprintf(" ORDER BY %s LIMIT %d OFFSET %d", strings.Join(t.key, ", "), limit, q.Offset)
+
+	rows, err := idx.db.Query(statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []map[string]interface{}{}
+	for rows.Next() {
+		dest := make([]interface{}, len(t.columns))
+		for i, c := range t.columns {
+			if c.kind == kindInteger {
+				dest[i] = new(sql.NullInt64)
+			} else {
+				dest[i] = new(sql.NullString)
+			}
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{}, len(t.columns))
+		for i, c := range t.columns {
+			switch v := dest[i].(type) {
+			case *sql.NullInt64:
+				if v.Valid {
+					values[c.name] = v.Int64
+				}
+			case *sql.NullString:
+				if v.Valid {
+					values[c.name] = v.String
+				}
+			}
+		}
+		result = append(result, values)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,169 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+
+	"github.com/bianjieai/irita/app"
+	"github.com/bianjieai/irita/app/indexer"
+)
+
+const (
+	sender    = "iaa1sender"
+	recipient = "iaa1recipient"
+)
+
+func openIndexer(t *testing.T) *indexer.Indexer {
+	encodingConfig := app.MakeEncodingConfig()
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=5000", filepath.Join(t.TempDir(), "indexer.db"))
+	idx, err := indexer.Open(indexer.DriverSQLite, dsn, encodingConfig.InterfaceRegistry)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = idx.Close() })
+	return idx
+}
+
+// deliverTx returns a successful tx minting a nft and creating a record, with an
+// EVM log event
+func deliverTx(t *testing.T) (abci.RequestDeliverTx, abci.ResponseDeliverTx) {
+	encodingConfig := app.MakeEncodingConfig()
+	builder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(
+		&nfttypes.MsgMintNFT{Id: "nft", DenomId: "denom", Sender: sender, Recipient: recipient},
+		&recordtypes.MsgCreateRecord{Creator: sender},
+	))
+	txBytes, err := encodingConfig.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	recordRes, err := proto.Marshal(&recordtypes.MsgCreateRecordResponse{Id: "record"})
+	require.NoError(t, err)
+	data, err := proto.Marshal(&sdk.TxMsgData{Data: []*sdk.MsgData{
+		{MsgType: sdk.MsgTypeURL(&nfttypes.MsgMintNFT{})},
+		{MsgType: sdk.MsgTypeURL(&recordtypes.MsgCreateRecord{}), Data: recordRes},
+	}})
+	require.NoError(t, err)
+
+	log, err := json.Marshal(&evmtypes.Log{Address: "0xcontract", Topics: []string{"0xtopic"}, Data: []byte{1}, Index: 3})
+	require.NoError(t, err)
+
+	return abci.RequestDeliverTx{Tx: txBytes}, abci.ResponseDeliverTx{
+		Data: data,
+		Events: []abci.Event{{
+			Type:       evmtypes.EventTypeTxLog,
+			Attributes: []abci.EventAttribute{{Key: []byte(evmtypes.AttributeKeyTxLog), Value: log}},
+		}},
+	}
+}
+
+func indexBlock(t *testing.T, idx *indexer.Indexer, height int64, txs int) {
+	idx.ListenBeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height, Time: time.Now()}})
+	for i := 0; i < txs; i++ {
+		idx.ListenDeliverTx(deliverTx(t))
+	}
+	// a failed tx is indexed without its msgs
+	idx.ListenDeliverTx(abci.RequestDeliverTx{Tx: []byte("invalid")}, abci.ResponseDeliverTx{Code: 2})
+	require.NoError(t, idx.ListenCommit())
+}
+
+func TestIndexer(t *testing.T) {
+	idx := openIndexer(t)
+
+	// the genesis commit is not indexed
+	require.NoError(t, idx.ListenCommit())
+	for height := int64(1); height <= 3; height++ {
+		indexBlock(t, idx, height, 1)
+	}
+	// a block indexed again replaces the previous rows
+	indexBlock(t, idx, 3, 2)
+
+	height, err := idx.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+
+	rows, err := idx.Query(indexer.Query{Table: indexer.TableTxs, Filters: map[string]string{"height": "3"}})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, int64(2), rows[2]["code"])
+
+	rows, err = idx.Query(indexer.Query{
+		Table:      indexer.TableNFT,
+		Filters:    map[string]string{"denom_id": "denom", "recipient": recipient},
+		FromHeight: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, int64(2), rows[0]["height"])
+	require.Equal(t, "nft", rows[0]["nft_id"])
+	require.Equal(t, sender, rows[0]["sender"])
+	require.Equal(t, "/irismod.nft.MsgMintNFT", rows[0]["msg_type"])
+
+	rows, err = idx.Query(indexer.Query{Table: indexer.TableRecord, Filters: map[string]string{"sender": sender}, ToHeight: 1})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "record", rows[0]["record_id"])
+	require.Equal(t, int64(1), rows[0]["msg_index"])
+
+	rows, err = idx.Query(indexer.Query{Table: indexer.TableEVMLogs, Filters: map[string]string{"address": "0xcontract"}, Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "0xtopic", rows[0]["topic0"])
+	require.Equal(t, "0x01", rows[0]["data"])
+	require.NotContains(t, rows[0], "topic1")
+
+	_, err = idx.Query(indexer.Query{Table: indexer.TableNFT, Filters: map[string]string{"unknown": "x"}})
+	require.Error(t, err)
+	_, err = idx.Query(indexer.Query{Table: indexer.TableTxs, Filters: map[string]string{"code": "x"}})
+	require.Error(t, err)
+}
+
+func TestQueryRoute(t *testing.T) {
+	idx := openIndexer(t)
+	indexBlock(t, idx, 1, 1)
+
+	router := mux.NewRouter()
+	indexer.RegisterRoutes(router, idx)
+
+	query := func(url string) (int, indexer.QueryResponse) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+
+		var res indexer.QueryResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		}
+		return w.Code, res
+	}
+
+	code, res := query("/indexer/nft_msgs?denom_id=denom&from_height=1&to_height=1")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, res.Rows, 1)
+	require.Equal(t, recipient, res.Rows[0]["recipient"])
+
+	code, res = query("/indexer/nft_msgs?denom_id=other")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, res.Rows)
+
+	code, _ = query("/indexer/unknown")
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = query("/indexer/nft_msgs?limit=x")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = query("/indexer/nft_msgs?unknown=x")
+	require.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,89 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+// The query parameters other than the column filters
+const (
+	ParamFromHeight = "from_height"
+	ParamToHeight   = "to_height"
+	ParamLimit      = "limit"
+	ParamOffset     = "offset"
+)
+
+// QueryResponse is the response of the indexer queries
+type QueryResponse struct {
+	Rows []map[string]interface{} `json:"rows"`
+}
+
+// RegisterRoutes registers the indexer query route, GET /indexer/{table}, whose
+// query parameters are column values and the optional height range and pagination,
+// e.g. /indexer/nft_msgs?denom_id=x&from_height=100&to_height=200
+func RegisterRoutes(r *mux.Router, idx *Indexer) {
+	r.HandleFunc("/indexer/{table}", queryHandlerFn(idx)).Methods("GET")
+}
+
+func queryHandlerFn(idx *Indexer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := Query{
+			Table:   mux.Vars(r)["table"],
+			Filters: make(map[string]string),
+		}
+		if _, ok := getTable(q.Table); !ok {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("unknown table %s", q.Table))
+			return
+		}
+
+		for name, values := range r.URL.Query() {
+			value := values[0]
+
+			var err error
+			switch name {
+			case ParamFromHeight:
+				q.FromHeight, err = parseInt(name, value)
+			case ParamToHeight:
+				q.ToHeight, err = parseInt(name, value)
+			case ParamLimit:
+				q.Limit, err = strconv.Atoi(value)
+			case ParamOffset:
+				q.Offset, err = strconv.Atoi(value)
+			default:
+				q.Filters[name] = value
+			}
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+		if q.Offset < 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "negative offset")
+			return
+		}
+
+		rows, err := idx.Query(q)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		bz, err := json.Marshal(QueryResponse{Rows: rows})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
+
+func parseInt(name, value string) (int64, error) {
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s: %w", name, value, err)
+	}
+	return i, nil
+}
@@ -0,0 +1,147 @@
+package indexer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The indexed tables
+const (
+	TableBlocks   = "blocks"
+	TableTxs      = "txs"
+	TableNFT      = "nft_msgs"
+	TableMT       = "mt_msgs"
+	TableToken    = "token_msgs"
+	TableRecord   = "record_msgs"
+	TableIdentity = "identity_msgs"
+	TableService  = "service_msgs"
+	TableEVMLogs  = "evm_logs"
+)
+
+type columnKind int
+
+const (
+	kindInteger columnKind = iota
+	kindText
+)
+
+type column struct {
+	name string
+	kind columnKind
+}
+
+// table describes an indexed table, ordered by its key columns
+type table struct {
+	name    string
+	columns []column
+	key     []string
+	indexes []string
+}
+
+func integer(name string) column { return column{name: name, kind: kindInteger} }
+func text(name string) column    { return column{name: name, kind: kindText} }
+
+// msgColumns are the leading columns of the tables indexing the tx messages
+var msgColumns = []column{
+	integer("height"), integer("tx_index"), text("tx_hash"), integer("msg_index"), text("msg_type"), text("sender"),
+}
+
+func msgTable(name string, columns ...column) table {
+	return table{
+		name:    name,
+		columns: append(append([]column{}, msgColumns...), columns...),
+		key:     []string{"height", "tx_index", "msg_index"},
+		indexes: []string{"sender"},
+	}
+}
+
+// tables is the schema of the indexer, compatible with SQLite and PostgreSQL
+var tables = []table{
+	{
+		name:    TableBlocks,
+		columns: []column{integer("height"), text("time"), integer("num_txs")},
+		key:     []string{"height"},
+	},
+	{
+		name: TableTxs,
+		columns: []column{
+			integer("height"), integer("tx_index"), text("hash"), integer("code"), text("codespace"),
+			integer("gas_wanted"), integer("gas_used"), text("log"),
+		},
+		key:     []string{"height", "tx_index"},
+		indexes: []string{"hash"},
+	},
+	withIndexes(msgTable(TableNFT, text("denom_id"), text("nft_id"), text("recipient")), "denom_id", "recipient"),
+	withIndexes(msgTable(TableMT, text("denom_id"), text("mt_id"), text("recipient"), text("amount")), "denom_id", "recipient"),
+	withIndexes(msgTable(TableToken, text("symbol"), text("recipient"), text("amount")), "symbol", "recipient"),
+	withIndexes(msgTable(TableRecord, text("record_id")), "record_id"),
+	withIndexes(msgTable(TableIdentity, text("identity_id")), "identity_id"),
+	withIndexes(msgTable(TableService, text("service_name"), text("provider"), text("request_context_id"), text("request_id")), "service_name", "provider", "request_context_id"),
+	{
+		name: TableEVMLogs,
+		columns: []column{
+			integer("height"), integer("tx_index"), text("tx_hash"), text("eth_tx_hash"), integer("log_index"),
+			text("address"), text("topic0"), text("topic1"), text("topic2"), text("topic3"), text("data"),
+		},
+		key:     []string{"height", "tx_index", "log_index"},
+		indexes: []string{"address", "topic0", "eth_tx_hash"},
+	},
+}
+
+func withIndexes(t table, indexes ...string) table {
+	t.indexes = append(t.indexes, indexes...)
+	return t
+}
+
+// getTable returns the table of the given name
+func getTable(name string) (table, bool) {
+	for _, t := range tables {
+		if t.name == name {
+			return t, true
+		}
+	}
+	return table{}, false
+}
+
+// column returns the column of the given name
+func (t table) column(name string) (column, bool) {
+	for _, c := range t.columns {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return column{}, false
+}
+
+// createStatements returns the statements creating the table and its indexes
+func (t table) createStatements() []string {
+	var defs []string
+	for _, c := range t.columns {
+		kind := "TEXT"
+		if c.kind == kindInteger {
+			kind = "BIGINT"
+		}
+		defs = append(defs, fmt.Sprintf("%s %s", c.name, kind))
+	}
+	defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(t.key, ", ")))
+
+	statements := []string{fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", t.name, strings.Join(defs, ", "))}
+	for _, name := range t.indexes {
+		statements = append(statements, fmt.Sprintf(
+			"CREATE INDEX IF NOT EXISTS %s_%s ON %s (%s, height)", t.name, name, t.name, name,
+		))
+	}
+	return statements
+}
+
+// insertStatement returns the statement inserting a row, with the values in the
+// order of the columns
+func (t table) insertStatement() string {
+	names := make([]string, len(t.columns))
+	params := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		names[i] = c.name
+		params[i] = fmt.Sprintf("$%d", i+1)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", t.name, strings.Join(names, ", "), strings.Join(params, ", "))
+}
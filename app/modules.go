@@ -0,0 +1,407 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	tibcmttransfer "github.com/bianjieai/tibc-go/modules/tibc/apps/mt_transfer"
+	tibcmttypes "github.com/bianjieai/tibc-go/modules/tibc/apps/mt_transfer/types"
+	tibcnfttransfer "github.com/bianjieai/tibc-go/modules/tibc/apps/nft_transfer"
+	tibcnfttypes "github.com/bianjieai/tibc-go/modules/tibc/apps/nft_transfer/types"
+	tibchost "github.com/bianjieai/tibc-go/modules/tibc/core/24-host"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gogotypes "github.com/gogo/protobuf/types"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	"github.com/irisnet/irismod/modules/mt"
+	mttypes "github.com/irisnet/irismod/modules/mt/types"
+	"github.com/irisnet/irismod/modules/oracle"
+	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
+	"github.com/irisnet/irismod/modules/random"
+	randomtypes "github.com/irisnet/irismod/modules/random/types"
+	"github.com/irisnet/irismod/modules/service"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	"github.com/tharsis/ethermint/x/feemarket"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+
+	"github.com/bianjieai/irita/modules/coinswap"
+	"github.com/bianjieai/irita/modules/erc20"
+	erc20types "github.com/bianjieai/irita/modules/erc20/types"
+	"github.com/bianjieai/irita/modules/evm"
+	"github.com/bianjieai/irita/modules/feeabs"
+	feeabstypes "github.com/bianjieai/irita/modules/feeabs/types"
+	tibc "github.com/bianjieai/irita/modules/tibc"
+	fttransfer "github.com/bianjieai/irita/modules/tibc/apps/ft_transfer"
+	fttransfertypes "github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+// FlagDisabledModules is the app.toml option (`disabled` in the `[modules]` section)
+// listing the optional modules the node runs without
+const FlagDisabledModules = "modules.disabled"
+
+// DisabledModulesKey is the key of the app state listing the optional modules the chain
+// runs without, none if absent. The nodes must disable the same modules, as the stores of
+// the enabled modules make the app hash.
+const DisabledModulesKey = "disabled_modules"
+
+// optionalModule defines the modules, stores and module accounts enabled or
+// disabled together
+type optionalModule struct {
+	modules            []string
+	storeKeys          []string
+	transientStoreKeys []string
+	moduleAccounts     []string
+	// requires lists the optional modules it cannot run without
+	requires []string
+}
+
+// optionalModules are the modules which can be disabled, by name
+var optionalModules = map[string]optionalModule{
+	servicetypes.ModuleName: {
+		modules:        []string{servicetypes.ModuleName},
+		storeKeys:      []string{servicetypes.StoreKey},
+		moduleAccounts: []string{servicetypes.DepositAccName, servicetypes.RequestAccName},
+	},
+	oracletypes.ModuleName: {
+		modules:   []string{oracletypes.ModuleName},
+		storeKeys: []string{oracletypes.StoreKey},
+		requires:  []string{servicetypes.ModuleName},
+	},
+	feeabstypes.ModuleName: {
+		modules:   []string{feeabstypes.ModuleName},
+		storeKeys: []string{feeabstypes.StoreKey},
+		requires:  []string{oracletypes.ModuleName},
+	},
+	randomtypes.ModuleName: {
+		modules:   []string{randomtypes.ModuleName},
+		storeKeys: []string{randomtypes.StoreKey},
+		requires:  []string{servicetypes.ModuleName},
+	},
+	mttypes.ModuleName: {
+		modules:   []string{mttypes.ModuleName},
+		storeKeys: []string{mttypes.StoreKey},
+	},
+	coinswaptypes.ModuleName: {
+		modules:        []string{coinswaptypes.ModuleName},
+		storeKeys:      []string{coinswaptypes.StoreKey},
+		moduleAccounts: []string{coinswaptypes.ModuleName},
+	},
+	tibchost.ModuleName: {
+		modules:        []string{tibchost.ModuleName, tibcnfttypes.ModuleName, tibcmttypes.ModuleName, fttransfertypes.ModuleName},
+		storeKeys:      []string{tibchost.StoreKey, tibcnfttypes.StoreKey, tibcmttypes.StoreKey, fttransfertypes.StoreKey},
+		moduleAccounts: []string{tibcnfttypes.ModuleName, tibcmttypes.ModuleName, fttransfertypes.ModuleName},
+		requires:       []string{mttypes.ModuleName},
+	},
+	evmtypes.ModuleName: {
+		modules:            []string{evmtypes.ModuleName, feemarkettypes.ModuleName, erc20types.ModuleName},
+		storeKeys:          []string{evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey},
+		transientStoreKeys: []string{evmtypes.TransientKey},
+		moduleAccounts:     []string{evmtypes.ModuleName, erc20types.ModuleName},
+	},
+}
+
+// AddModuleInitFlags adds the module selection flag to the start command
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().StringSlice(
+		FlagDisabledModules, nil,
+		fmt.Sprintf("Optional modules to disable, among %s", strings.Join(optionalModuleNames(), ", ")),
+	)
+}
+
+// optionalModuleNames returns the sorted names of the optional modules
+func optionalModuleNames() []string {
+	names := make([]string, 0, len(optionalModules))
+	for name := range optionalModules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ModuleSelection holds the optional modules disabled on the node
+type ModuleSelection struct {
+	disabled map[string]bool
+}
+
+// NewModuleSelection creates a ModuleSelection from the names of the optional
+// modules to disable, checking that the enabled modules do not require them
+func NewModuleSelection(disabled []string) (ModuleSelection, error) {
+	s := ModuleSelection{disabled: make(map[string]bool)}
+	for _, name := range disabled {
+		if _, ok := optionalModules[name]; !ok {
+			return s, fmt.Errorf(
+				"unknown optional module %s, expected one of %s",
+				name, strings.Join(optionalModuleNames(), ", "),
+			)
+		}
+		s.disabled[name] = true
+	}
+
+	for _, name := range optionalModuleNames() {
+		if s.disabled[name] {
+			continue
+		}
+		for _, required := range optionalModules[name].requires {
+			if s.disabled[required] {
+				return s, fmt.Errorf("module %s requires the disabled module %s", name, required)
+			}
+		}
+	}
+	return s, nil
+}
+
+// IsEnabled returns true unless the given optional module is disabled
+func (s ModuleSelection) IsEnabled(name string) bool {
+	return !s.disabled[name]
+}
+
+// Disabled returns the sorted names of the disabled modules
+func (s ModuleSelection) Disabled() []string {
+	var names []string
+	for _, name := range optionalModuleNames() {
+		if s.disabled[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// setGenesis sets the disabled modules in the app state, if any
+func (s ModuleSelection) setGenesis(genesisState GenesisState) error {
+	if len(s.disabled) == 0 {
+		return nil
+	}
+	bz, err := json.Marshal(s.Disabled())
+	if err != nil {
+		return err
+	}
+	genesisState[DisabledModulesKey] = bz
+	return nil
+}
+
+// checkGenesis returns an error unless the app state disables the same modules
+func (s ModuleSelection) checkGenesis(genesisState GenesisState) error {
+	var disabled []string
+	if bz, ok := genesisState[DisabledModulesKey]; ok {
+		if err := json.Unmarshal(bz, &disabled); err != nil {
+			return fmt.Errorf("invalid %s: %w", DisabledModulesKey, err)
+		}
+	}
+	genesis, err := NewModuleSelection(disabled)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", DisabledModulesKey, err)
+	}
+
+	if strings.Join(genesis.Disabled(), ",") != strings.Join(s.Disabled(), ",") {
+		return fmt.Errorf(
+			"the genesis disables the modules [%s] while the node disables [%s], see %s",
+			strings.Join(genesis.Disabled(), ", "), strings.Join(s.Disabled(), ", "), FlagDisabledModules,
+		)
+	}
+	return nil
+}
+
+// disabledSet returns the modules, stores or module accounts of the disabled modules
+func (s ModuleSelection) disabledSet(field func(optionalModule) []string) map[string]bool {
+	set := make(map[string]bool)
+	for name := range s.disabled {
+		for _, item := range field(optionalModules[name]) {
+			set[item] = true
+		}
+	}
+	return set
+}
+
+// storeKeys filters out the store keys of the disabled modules
+func (s ModuleSelection) storeKeys(keys []string) []string {
+	disabled := s.disabledSet(func(m optionalModule) []string { return m.storeKeys })
+	return filterNames(keys, disabled)
+}
+
+// transientStoreKeys filters out the transient store keys of the disabled modules
+func (s ModuleSelection) transientStoreKeys(keys []string) []string {
+	disabled := s.disabledSet(func(m optionalModule) []string { return m.transientStoreKeys })
+	return filterNames(keys, disabled)
+}
+
+// maccPerms filters out the module accounts of the disabled modules
+func (s ModuleSelection) maccPerms(perms map[string][]string) map[string][]string {
+	disabled := s.disabledSet(func(m optionalModule) []string { return m.moduleAccounts })
+
+	enabled := make(map[string][]string)
+	for acc, p := range perms {
+		if !disabled[acc] {
+			enabled[acc] = p
+		}
+	}
+	return enabled
+}
+
+// moduleBasics filters out the module basics of the disabled modules
+func (s ModuleSelection) moduleBasics(basics module.BasicManager) module.BasicManager {
+	disabled := s.disabledSet(func(m optionalModule) []string { return m.modules })
+
+	enabled := make(module.BasicManager)
+	for name, basic := range basics {
+		if !disabled[name] {
+			enabled[name] = basic
+		}
+	}
+	return enabled
+}
+
+// checkChain returns an error unless the chain committed to the given db runs the
+// enabled modules, and only them, as the stores mounted by the node make its app hash.
+// The chain runs the modules of the stores of its latest commit info. An enabled
+// module may miss its stores until the upgrade adding them.
+func (s ModuleSelection) checkChain(db dbm.DB) error {
+	commitInfo, err := latestCommitInfo(db)
+	if err != nil || commitInfo == nil {
+		return err
+	}
+
+	committed := make(map[string]bool)
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committed[storeInfo.Name] = true
+	}
+
+	for _, name := range optionalModuleNames() {
+		runs := false
+		for _, key := range optionalModules[name].storeKeys {
+			runs = runs || committed[key]
+		}
+
+		switch {
+		case s.disabled[name] && runs:
+			return fmt.Errorf("the chain runs the %s module at height %d, it cannot be disabled", name, commitInfo.Version)
+		case !s.disabled[name] && !runs && !addedByPendingUpgrade(optionalModules[name], committed):
+			return fmt.Errorf("the chain runs without the %s module at height %d, it must be disabled", name, commitInfo.Version)
+		}
+	}
+	return nil
+}
+
+// addedByPendingUpgrade returns true if an upgrade not applied yet, none of its added
+// stores being committed, adds the stores of the module
+func addedByPendingUpgrade(m optionalModule, committed map[string]bool) bool {
+	for _, u := range upgrades {
+		applied, adds := false, false
+		for _, key := range u.StoreUpgrades.Added {
+			applied = applied || committed[key]
+			for _, moduleKey := range m.storeKeys {
+				adds = adds || key == moduleKey
+			}
+		}
+		if adds && !applied {
+			return true
+		}
+	}
+	return false
+}
+
+// latestCommitInfo returns the commit info of the latest version committed to the db,
+// nil if none
+func latestCommitInfo(db dbm.DB) (*storetypes.CommitInfo, error) {
+	bz, err := db.Get([]byte("s/latest"))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var latestVersion int64
+	if err := gogotypes.StdInt64Unmarshal(&latestVersion, bz); err != nil {
+		return nil, err
+	}
+
+	bz, err = db.Get([]byte(fmt.Sprintf("s/%d", latestVersion)))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var commitInfo storetypes.CommitInfo
+	if err := commitInfo.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return &commitInfo, nil
+}
+
+// optionalAppModules returns the enabled optional modules
+func (app *IritaApp) optionalAppModules() []module.AppModule {
+	var modules []module.AppModule
+	if app.modules.IsEnabled(mttypes.ModuleName) {
+		modules = append(modules, mt.NewAppModule(app.appCodec, app.mtKeeper, app.accountKeeper, app.bankKeeper))
+	}
+	if app.modules.IsEnabled(coinswaptypes.ModuleName) {
+		modules = append(modules, coinswap.NewAppModule(app.appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper))
+	}
+	if app.modules.IsEnabled(servicetypes.ModuleName) {
+		modules = append(modules, service.NewAppModule(app.appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper))
+	}
+	if app.modules.IsEnabled(oracletypes.ModuleName) {
+		modules = append(modules, oracle.NewAppModule(app.appCodec, app.oracleKeeper, app.accountKeeper, app.bankKeeper))
+	}
+	if app.modules.IsEnabled(feeabstypes.ModuleName) {
+		modules = append(modules, feeabs.NewAppModule(app.feeAbsKeeper))
+	}
+	if app.modules.IsEnabled(randomtypes.ModuleName) {
+		modules = append(modules, random.NewAppModule(app.appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper))
+	}
+	if app.modules.IsEnabled(tibchost.ModuleName) {
+		modules = append(modules,
+			tibc.NewAppModule(app.tibcKeeper),
+			tibcnfttransfer.NewAppModule(app.nftTransferKeeper),
+			tibcmttransfer.NewAppModule(app.mtTransferKeeper),
+			fttransfer.NewAppModule(app.ftTransferKeeper),
+		)
+	}
+	if app.modules.IsEnabled(evmtypes.ModuleName) {
+		modules = append(modules,
+			evm.NewAppModule(app.EvmKeeper, app.accountKeeper, app.precompiles),
+			feemarket.NewAppModule(app.FeeMarketKeeper),
+			erc20.NewAppModule(app.erc20Keeper, app.accountKeeper),
+		)
+	}
+	return modules
+}
+
+// removeDisabledModules removes the disabled modules from the module manager orders
+func (app *IritaApp) removeDisabledModules() {
+	disabled := app.modules.disabledSet(func(m optionalModule) []string { return m.modules })
+
+	app.mm.OrderBeginBlockers = filterNames(app.mm.OrderBeginBlockers, disabled)
+	app.mm.OrderEndBlockers = filterNames(app.mm.OrderEndBlockers, disabled)
+	app.mm.OrderInitGenesis = filterNames(app.mm.OrderInitGenesis, disabled)
+	app.mm.OrderExportGenesis = filterNames(app.mm.OrderExportGenesis, disabled)
+	app.mm.OrderMigrations = filterNames(app.mm.OrderMigrations, disabled)
+}
+
+// addSimulationModules adds the modules supporting the simulation to the simulation manager
+func (app *IritaApp) addSimulationModules(modules []module.AppModule) {
+	for _, m := range modules {
+		if sm, ok := m.(module.AppModuleSimulation); ok {
+			app.sm.Modules = append(app.sm.Modules, sm)
+		}
+	}
+}
+
+// filterNames returns the names not in the excluded set
+func filterNames(names []string, excluded map[string]bool) []string {
+	filtered := make([]string, 0, len(names))
+	for _, name := range names {
+		if !excluded[name] {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
+}
+
+// newModuleSelection reads the module selection from the app options
+func newModuleSelection(appOpts servertypes.AppOptions) (ModuleSelection, error) {
+	return NewModuleSelection(cast.ToStringSlice(appOpts.Get(FlagDisabledModules)))
+}
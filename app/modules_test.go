@@ -0,0 +1,105 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestNewModuleSelection(t *testing.T) {
+	s, err := NewModuleSelection(nil)
+	require.NoError(t, err)
+	for _, name := range optionalModuleNames() {
+		require.True(t, s.IsEnabled(name))
+	}
+
+	s, err = NewModuleSelection([]string{"evm", "tibc"})
+	require.NoError(t, err)
+	require.False(t, s.IsEnabled("evm"))
+	require.False(t, s.IsEnabled("tibc"))
+	require.True(t, s.IsEnabled("mt"))
+
+	_, err = NewModuleSelection([]string{"bank"})
+	require.Error(t, err, "only the optional modules can be disabled")
+
+	_, err = NewModuleSelection([]string{"service"})
+	require.Error(t, err, "oracle and random require service")
+
+	_, err = NewModuleSelection([]string{"oracle"})
+	require.Error(t, err, "feeabs requires oracle")
+
+	_, err = NewModuleSelection([]string{"service", "oracle", "random", "feeabs"})
+	require.NoError(t, err)
+}
+
+func TestDisabledModules(t *testing.T) {
+	appOpts := mapAppOptions{FlagDisabledModules: []string{"evm", "tibc"}}
+	disabledDB := dbm.NewMemDB()
+	app := NewIritaApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), disabledDB, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), appOpts, interBlockCacheOpt())
+
+	require.Nil(t, app.GetKey("evm"))
+	require.Nil(t, app.GetKey("tibc"))
+	require.Nil(t, app.EvmKeeper)
+	require.NotContains(t, app.mm.Modules, "evm")
+	require.NotContains(t, app.mm.OrderBeginBlockers, "feemarket")
+	require.NotContains(t, app.maccPerms, "evm")
+	require.NoError(t, setGenesis(app))
+
+	db := dbm.NewMemDB()
+	app = NewIritaApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{}, interBlockCacheOpt())
+	require.NoError(t, setGenesis(app))
+
+	// the nodes must run the modules of the chain, the stores of the modules making the app hash
+	s, err := NewModuleSelection([]string{"evm", "tibc"})
+	require.NoError(t, err)
+	require.NoError(t, s.checkChain(disabledDB))
+	require.Error(t, s.checkChain(db))
+
+	s, err = NewModuleSelection(nil)
+	require.NoError(t, err)
+	require.NoError(t, s.checkChain(db))
+	require.Error(t, s.checkChain(disabledDB))
+
+	// even if the disabled module has no state
+	s, err = NewModuleSelection([]string{"random"})
+	require.NoError(t, err)
+	require.Error(t, s.checkChain(db))
+}
+
+func TestCheckGenesis(t *testing.T) {
+	s, err := NewModuleSelection([]string{"tibc", "evm"})
+	require.NoError(t, err)
+
+	genesisState := GenesisState{}
+	require.Error(t, s.checkGenesis(genesisState))
+	require.NoError(t, s.setGenesis(genesisState))
+	require.JSONEq(t, `["evm","tibc"]`, string(genesisState[DisabledModulesKey]))
+	require.NoError(t, s.checkGenesis(genesisState))
+
+	genesisState[DisabledModulesKey] = []byte(`["evm"]`)
+	require.Error(t, s.checkGenesis(genesisState))
+	genesisState[DisabledModulesKey] = []byte(`["evm","tibc","bank"]`)
+	require.Error(t, s.checkGenesis(genesisState))
+
+	s, err = NewModuleSelection(nil)
+	require.NoError(t, err)
+	require.NoError(t, s.checkGenesis(GenesisState{}))
+}
+
+func TestAddedByPendingUpgrade(t *testing.T) {
+	// the v5.0.0 upgrade adds the coinswap store along with the perm one
+	coinswap := optionalModules["coinswap"]
+	require.True(t, addedByPendingUpgrade(coinswap, map[string]bool{"bank": true}))
+	require.False(t, addedByPendingUpgrade(coinswap, map[string]bool{"bank": true, "perm": true}))
+	require.False(t, addedByPendingUpgrade(optionalModules["service"], map[string]bool{"bank": true}))
+}
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
+
+	appante "github.com/bianjieai/irita/app/ante"
+	permkeeper "github.com/bianjieai/irita/modules/perm/keeper"
+)
+
+// Plugin extends the IRITA app with the modules built by downstream projects.
+// All the fields are optional.
+type Plugin struct {
+	// Name is the unique name of the plugin
+	Name string
+
+	// ModuleBasics are registered in the app ModuleBasics
+	ModuleBasics []module.AppModuleBasic
+	// StoreKeys, TransientStoreKeys and MemoryStoreKeys are created and mounted
+	// along with the IRITA stores, see GetKey, GetTKey and GetMemKey
+	StoreKeys          []string
+	TransientStoreKeys []string
+	MemoryStoreKeys    []string
+	// MaccPerms are the module accounts permissions of the plugin modules
+	MaccPerms map[string][]string
+	// Subspaces are the param subspaces created in the params keeper, see GetSubspace
+	Subspaces []string
+
+	// NewModules creates the plugin keepers and returns the plugin modules. It is
+	// called once all the IRITA keepers are created.
+	NewModules func(app *IritaApp) []module.AppModule
+	// Ordering places the plugin modules in the module manager orders
+	Ordering Ordering
+
+	// AnteDecorators returns the decorators appended to the IRITA ante handlers
+	AnteDecorators func(app *IritaApp, options appante.HandlerOptions) []sdk.AnteDecorator
+	// RegisterUpgradePlans registers the upgrade plans of the plugin, see RegisterUpgradePlan
+	RegisterUpgradePlans func(app *IritaApp, configurator module.Configurator)
+	// RegisterAPIRoutes registers the API routes in addition to the gRPC gateway
+	// routes of the plugin ModuleBasics
+	RegisterAPIRoutes func(apiSvr *api.Server, apiConfig config.APIConfig)
+}
+
+// Ordering maps the plugin module names to the name of the module they run right
+// after, which can be an IRITA module or a module of a previously sorted plugin.
+// The modules without constraint run after all the other modules. The init genesis
+// order is also used to export the genesis.
+type Ordering struct {
+	BeginBlockers map[string]string
+	EndBlockers   map[string]string
+	InitGenesis   map[string]string
+	Migrations    map[string]string
+}
+
+// plugins are the registered plugins, sorted by name
+var plugins []Plugin
+
+// RegisterPlugins registers the plugins assembled by NewIritaApp. It must be called
+// before creating the app, usually from the init function of the downstream project.
+func RegisterPlugins(ps ...Plugin) {
+	for _, p := range ps {
+		if len(p.Name) == 0 {
+			panic("plugin name cannot be empty")
+		}
+		for _, registered := range plugins {
+			if registered.Name == p.Name {
+				panic(fmt.Sprintf("plugin %s already registered", p.Name))
+			}
+		}
+
+		for _, moduleBasic := range p.ModuleBasics {
+			if _, ok := ModuleBasics[moduleBasic.Name()]; ok {
+				panic(fmt.Sprintf("plugin %s: module %s already registered", p.Name, moduleBasic.Name()))
+			}
+			ModuleBasics[moduleBasic.Name()] = moduleBasic
+		}
+		for acc, perms := range p.MaccPerms {
+			if _, ok := maccPerms[acc]; ok {
+				panic(fmt.Sprintf("plugin %s: module account %s already registered", p.Name, acc))
+			}
+			maccPerms[acc] = perms
+		}
+
+		storeKeys = append(storeKeys, p.StoreKeys...)
+		transientStoreKeys = append(transientStoreKeys, p.TransientStoreKeys...)
+		memoryStoreKeys = append(memoryStoreKeys, p.MemoryStoreKeys...)
+
+		plugins = append(plugins, p)
+	}
+
+	sort.SliceStable(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+}
+
+// newPluginModules creates the modules of all the plugins
+func (app *IritaApp) newPluginModules() []module.AppModule {
+	var modules []module.AppModule
+	for _, p := range plugins {
+		if p.NewModules != nil {
+			modules = append(modules, p.NewModules(app)...)
+		}
+	}
+	return modules
+}
+
+// addPluginModules adds the plugin modules to the module manager orders
+func (app *IritaApp) addPluginModules(modules []module.AppModule) {
+	for _, m := range modules {
+		if _, ok := app.mm.Modules[m.Name()]; ok {
+			panic(fmt.Sprintf("module %s already registered", m.Name()))
+		}
+		app.mm.Modules[m.Name()] = m
+	}
+
+	var names []string
+	for _, m := range modules {
+		names = append(names, m.Name())
+	}
+
+	orders := func(order func(Ordering) map[string]string) map[string]string {
+		constraints := make(map[string]string)
+		for _, p := range plugins {
+			for name, after := range order(p.Ordering) {
+				constraints[name] = after
+			}
+		}
+		return constraints
+	}
+	beginBlockers := orders(func(o Ordering) map[string]string { return o.BeginBlockers })
+	endBlockers := orders(func(o Ordering) map[string]string { return o.EndBlockers })
+	initGenesis := orders(func(o Ordering) map[string]string { return o.InitGenesis })
+	migrations := orders(func(o Ordering) map[string]string { return o.Migrations })
+
+	app.mm.OrderBeginBlockers = insertModules(app.mm.OrderBeginBlockers, names, beginBlockers)
+	app.mm.OrderEndBlockers = insertModules(app.mm.OrderEndBlockers, names, endBlockers)
+	app.mm.OrderInitGenesis = insertModules(app.mm.OrderInitGenesis, names, initGenesis)
+	app.mm.OrderExportGenesis = insertModules(app.mm.OrderExportGenesis, names, initGenesis)
+	app.mm.OrderMigrations = insertModules(app.mm.OrderMigrations, names, migrations)
+}
+
+// insertModules inserts the given modules in the order, each one right after the
+// module it runs after or at the end. The modules inserted after the same module
+// keep their relative order.
+func insertModules(order []string, names []string, after map[string]string) []string {
+	result := append([]string{}, order...)
+	last := make(map[string]string)
+	for _, name := range names {
+		anchor, ok := after[name]
+		if !ok {
+			result = append(result, name)
+			continue
+		}
+
+		prev := anchor
+		if l, ok := last[anchor]; ok {
+			prev = l
+		}
+
+		idx := -1
+		for i, n := range result {
+			if n == prev {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			panic(fmt.Sprintf("module %s cannot run after the unknown module %s", name, anchor))
+		}
+
+		result = append(result[:idx+1], append([]string{name}, result[idx+1:]...)...)
+		last[anchor] = name
+	}
+	return result
+}
+
+// pluginAnteDecorators returns the ante decorators of all the plugins
+func (app *IritaApp) pluginAnteDecorators(options appante.HandlerOptions) []sdk.AnteDecorator {
+	var decorators []sdk.AnteDecorator
+	for _, p := range plugins {
+		if p.AnteDecorators != nil {
+			decorators = append(decorators, p.AnteDecorators(app, options)...)
+		}
+	}
+	return decorators
+}
+
+// registerPluginUpgradePlans registers the upgrade plans of all the plugins
+func (app *IritaApp) registerPluginUpgradePlans() {
+	for _, p := range plugins {
+		if p.RegisterUpgradePlans != nil {
+			p.RegisterUpgradePlans(app, app.configurator)
+		}
+	}
+}
+
+// registerPluginAPIRoutes registers the API routes of all the plugins
+func registerPluginAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
+	for _, p := range plugins {
+		if p.RegisterAPIRoutes != nil {
+			p.RegisterAPIRoutes(apiSvr, apiConfig)
+		}
+	}
+}
+
+// AccountKeeper returns the account keeper for the plugin keepers.
+func (app *IritaApp) AccountKeeper() authkeeper.AccountKeeper {
+	return app.accountKeeper
+}
+
+// BankKeeper returns the bank keeper for the plugin keepers.
+func (app *IritaApp) BankKeeper() permkeeper.BankKeeper {
+	return app.bankKeeper
+}
+
+// TokenKeeper returns the token keeper for the plugin keepers.
+func (app *IritaApp) TokenKeeper() tokenkeeper.Keeper {
+	return app.tokenKeeper
+}
+
+// PermKeeper returns the perm keeper for the plugin keepers.
+func (app *IritaApp) PermKeeper() permkeeper.Keeper {
+	return app.permKeeper
+}
+
+// CapabilityKeeper returns the capability keeper, whose ScopeToModule must be called
+// by the plugin keepers before the keeper is sealed.
+func (app *IritaApp) CapabilityKeeper() *capabilitykeeper.Keeper {
+	return app.capabilityKeeper
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertModules(t *testing.T) {
+	order := []string{"auth", "bank", "token", "evm"}
+
+	result := insertModules(order, []string{"a", "b", "c", "d"}, map[string]string{
+		"a": "bank",
+		"b": "bank",
+		"d": "a",
+	})
+	require.Equal(t, []string{"auth", "bank", "a", "d", "b", "token", "evm", "c"}, result)
+	require.Equal(t, []string{"auth", "bank", "token", "evm"}, order, "the order should not be modified")
+
+	require.Panics(t, func() {
+		insertModules(order, []string{"a"}, map[string]string{"a": "unknown"})
+	})
+}
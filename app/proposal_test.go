@@ -0,0 +1,99 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	clienttypes "github.com/bianjieai/tibc-go/modules/tibc/core/02-client/types"
+	commitmenttypes "github.com/bianjieai/tibc-go/modules/tibc/core/23-commitment/types"
+	tibctmtypes "github.com/bianjieai/tibc-go/modules/tibc/light-clients/07-tendermint/types"
+
+	cparamstypes "github.com/bianjieai/iritamod/modules/params/types"
+
+	msgfiltertypes "github.com/bianjieai/irita/modules/msgfilter/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	proposaltypes "github.com/bianjieai/irita/modules/proposal/types"
+	tibctypes "github.com/bianjieai/irita/modules/tibc/types"
+)
+
+// setupProposalApp begins a block in which the returned admin executes the
+// proposals on its own
+func setupProposalApp(t *testing.T) (*IritaApp, sdk.Context, sdk.AccAddress) {
+	// the fee market BeginBlocker requires the consensus params
+	appOpts := mapAppOptions{FlagDisabledModules: []string{"evm"}}
+	app := NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), appOpts)
+	require.NoError(t, setGenesis(app))
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, ChainID: "irita_1000-1", Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	admin := sdk.AccAddress(tmhash.SumTruncated([]byte("admin")))
+	app.permKeeper.SetRole(ctx, admin, permtypes.RoleProposalAdmin)
+	app.proposalKeeper.SetParams(ctx, proposaltypes.NewParams(1, time.Hour))
+	return app, ctx, admin
+}
+
+func TestUpgradeTIBCClientProposal(t *testing.T) {
+	app, ctx, admin := setupProposalApp(t)
+
+	clientState := func(height uint64) *tibctmtypes.ClientState {
+		return tibctmtypes.NewClientState(
+			"counterparty", tibctmtypes.DefaultTrustLevel, time.Hour, 2*time.Hour, time.Minute,
+			clienttypes.NewHeight(0, height), commitmenttypes.GetSDKSpecs(), commitmenttypes.NewMerklePrefix([]byte("tibc")), 0,
+		)
+	}
+	consensusState := tibctmtypes.NewConsensusState(ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), tmhash.Sum([]byte("vals")))
+
+	// the proposal module account is authorized as a TIBC admin
+	moduleAddr := app.proposalKeeper.GetModuleAddress()
+	execute := func(msg sdk.Msg, err error) {
+		require.NoError(t, err)
+
+		proposal, err := app.proposalKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "tibc client", "", admin)
+		require.NoError(t, err)
+		require.Equal(t, proposaltypes.StatusExecuted, proposal.Status, proposal.FailureReason)
+	}
+	execute(tibctypes.NewMsgCreateClient("counterparty", clientState(10), consensusState, moduleAddr))
+	execute(tibctypes.NewMsgUpgradeClient("counterparty", clientState(20), consensusState, moduleAddr))
+
+	upgraded, found := app.tibcKeeper.ClientKeeper.GetClientState(ctx, "counterparty")
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(0, 20), upgraded.GetLatestHeight())
+}
+
+func TestUpdateMsgFilterParamsProposal(t *testing.T) {
+	app, ctx, admin := setupProposalApp(t)
+
+	msgSendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	changes := []cparamstypes.ParamChange{{
+		Subspace: msgfiltertypes.ModuleName,
+		Key:      string(msgfiltertypes.KeyDeniedMsgTypes),
+		Value:    `["` + msgSendType + `"]`,
+	}}
+
+	// the cparams update of another account is rejected by the ante handler
+	priv := secp256k1.GenPrivKey()
+	update := cparamstypes.NewMsgUpdateParams(changes, sdk.AccAddress(priv.PubKey().Address()))
+	res := app.CheckTx(abci.RequestCheckTx{Tx: signTx(t, "irita_1000-1", priv, 0, 0, update)})
+	require.Equal(t, proposaltypes.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+	require.Equal(t, proposaltypes.ErrUnauthorized.Codespace(), res.Codespace)
+
+	msg := cparamstypes.NewMsgUpdateParams(changes, app.proposalKeeper.GetModuleAddress())
+
+	proposal, err := app.proposalKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "deny sends", "", admin)
+	require.NoError(t, err)
+	require.Equal(t, proposaltypes.StatusExecuted, proposal.Status, proposal.FailureReason)
+	require.Equal(t, []string{msgSendType}, app.msgFilterKeeper.GetParams(ctx).DeniedMsgTypes)
+}
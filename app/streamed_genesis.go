@@ -0,0 +1,203 @@
+package app
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+// StreamedAppStateKey is the key of an app state whose module states are streamed from
+// a file, one module at a time, rather than embedded in the genesis. The app state only
+// holds the disabled modules besides.
+const StreamedAppStateKey = "streamed_app_state"
+
+// StreamedAppState references the file of the module states, a JSON object of the
+// module states in the init genesis order
+type StreamedAppState struct {
+	// File is the path of the file, relative to the node config directory if not absolute
+	File string `json:"file"`
+	// Sha256 is the hex encoded hash of the file
+	Sha256 string `json:"sha256"`
+}
+
+// ExportAppStateToFile exports the state of the given modules, all the modules if
+// empty, to the file one module at a time. The returned app state references the
+// file by its base name.
+func (app *IritaApp) ExportAppStateToFile(forZeroHeight bool, jailAllowedAddrs []string, modules []string, path string) (servertypes.ExportedApp, error) {
+	selected := make(map[string]bool, len(modules))
+	for _, name := range modules {
+		if _, ok := app.mm.Modules[name]; !ok {
+			return servertypes.ExportedApp{}, fmt.Errorf("unknown or disabled module %s", name)
+		}
+		selected[name] = true
+	}
+
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	w := bufio.NewWriter(io.MultiWriter(file, hash))
+
+	// written in the init genesis order, for the import to init the modules as they are read
+	if _, err := w.WriteString("{"); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	first := true
+	for _, name := range app.mm.OrderInitGenesis {
+		if len(selected) > 0 && !selected[name] {
+			continue
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		if !first {
+			key = append([]byte(",\n"), key...)
+		}
+		first = false
+
+		if _, err := w.Write(append(key, ':')); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		state := app.mm.Modules[name].ExportGenesis(ctx, app.appCodec)
+		if len(state) == 0 {
+			state = json.RawMessage("null")
+		}
+		if _, err := w.Write(state); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+	if _, err := w.WriteString("}\n"); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	if err := w.Flush(); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	if err := file.Sync(); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	streamed, err := json.Marshal(StreamedAppState{File: filepath.Base(path), Sha256: hex.EncodeToString(hash.Sum(nil))})
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	genState := GenesisState{StreamedAppStateKey: streamed}
+	if err := app.modules.setGenesis(genState); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	return app.exportedApp(ctx, height, appState), nil
+}
+
+// initGenesisFromFile inits the modules from the file of the streamed app state,
+// reading one module state at a time. The file is hashed beforehand, for no module
+// to be initialized from a file not matching the genesis.
+//
+// Each module state is still decoded and initialized as a whole by its module, so
+// that the largest module state, such as the nft or evm one, must fit in memory.
+func (app *IritaApp) initGenesisFromFile(ctx sdk.Context, bz json.RawMessage) (abci.ResponseInitChain, error) {
+	var streamed StreamedAppState
+	if err := json.Unmarshal(bz, &streamed); err != nil {
+		return abci.ResponseInitChain{}, fmt.Errorf("invalid %s: %w", StreamedAppStateKey, err)
+	}
+
+	path := streamed.File
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(app.homePath, "config", path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return abci.ResponseInitChain{}, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, bufio.NewReader(file)); err != nil {
+		return abci.ResponseInitChain{}, err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != streamed.Sha256 {
+		return abci.ResponseInitChain{}, fmt.Errorf("the app state file %s hash %s does not match the genesis hash %s", path, sum, streamed.Sha256)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return abci.ResponseInitChain{}, err
+	}
+	dec := json.NewDecoder(bufio.NewReader(file))
+
+	if token, err := dec.Token(); err != nil || token != json.Delim('{') {
+		return abci.ResponseInitChain{}, fmt.Errorf("the app state file %s is not a JSON object", path)
+	}
+
+	var (
+		validatorUpdates []abci.ValidatorUpdate
+		next             int
+	)
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return abci.ResponseInitChain{}, err
+		}
+		name := token.(string)
+
+		var state json.RawMessage
+		if err := dec.Decode(&state); err != nil {
+			return abci.ResponseInitChain{}, fmt.Errorf("failed to read the %s state: %w", name, err)
+		}
+
+		// as the module manager, ignore the states of the modules it does not hold
+		if _, ok := app.mm.Modules[name]; !ok {
+			continue
+		}
+		i := indexOf(app.mm.OrderInitGenesis[next:], name)
+		if i < 0 {
+			return abci.ResponseInitChain{}, fmt.Errorf("the %s state is not in the init genesis order", name)
+		}
+		next += i + 1
+
+		if name == servicetypes.ModuleName {
+			state = app.addSystemServices(state)
+		}
+
+		// as the module manager, only one module may update the validator set
+		moduleValUpdates := app.mm.Modules[name].InitGenesis(ctx, app.appCodec, state)
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				return abci.ResponseInitChain{}, fmt.Errorf("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return abci.ResponseInitChain{}, err
+	}
+
+	return abci.ResponseInitChain{Validators: validatorUpdates}, nil
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
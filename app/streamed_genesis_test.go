@@ -0,0 +1,87 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestStreamedGenesis(t *testing.T) {
+	home := t.TempDir()
+	configDir := filepath.Join(home, "config")
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+
+	newApp := func() *IritaApp {
+		return NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), mapAppOptions{})
+	}
+	initChain := func(app *IritaApp, appState json.RawMessage) []byte {
+		app.InitChain(abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: appState,
+			ChainId:       "irita_1000-1",
+		})
+		return app.Commit().Data
+	}
+
+	app := newApp()
+	require.NoError(t, setGenesis(app))
+
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	streamed, err := app.ExportAppStateToFile(false, nil, nil, filepath.Join(configDir, "app_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, exported.Validators, streamed.Validators)
+	require.Equal(t, exported.Height, streamed.Height)
+
+	// the file holds the same module states, in the init genesis order
+	bz, err := os.ReadFile(filepath.Join(configDir, "app_state.json"))
+	require.NoError(t, err)
+	var exportedState, streamedState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &exportedState))
+	require.NoError(t, json.Unmarshal(bz, &streamedState))
+	require.Equal(t, len(exportedState), len(streamedState))
+	for name, state := range exportedState {
+		require.JSONEq(t, string(state), string(streamedState[name]), name)
+	}
+
+	// both genesis init the same state
+	hash := initChain(newApp(), exported.AppState)
+	require.Equal(t, hash, initChain(newApp(), streamed.AppState))
+
+	// the modules can be filtered
+	filtered, err := app.ExportAppStateToFile(false, nil, []string{banktypes.ModuleName, authtypes.ModuleName}, filepath.Join(configDir, "filtered.json"))
+	require.NoError(t, err)
+	bz, err = os.ReadFile(filepath.Join(configDir, "filtered.json"))
+	require.NoError(t, err)
+	streamedState = nil
+	require.NoError(t, json.Unmarshal(bz, &streamedState))
+	require.Len(t, streamedState, 2)
+	require.Contains(t, string(filtered.AppState), "filtered.json")
+
+	_, err = app.ExportAppStateToFile(false, nil, []string{"unknown"}, filepath.Join(configDir, "unknown.json"))
+	require.Error(t, err)
+
+	// a modified file is rejected
+	bz, err = os.ReadFile(filepath.Join(configDir, "app_state.json"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "app_state.json"), append(bz, ' '), 0o644))
+	require.Panics(t, func() { initChain(newApp(), streamed.AppState) })
+
+	// before any module is initialized
+	var genState GenesisState
+	require.NoError(t, json.Unmarshal(streamed.AppState, &genState))
+	modified := newApp()
+	ctx := modified.NewUncachedContext(false, tmproto.Header{})
+	_, err = modified.initGenesisFromFile(ctx, genState[StreamedAppStateKey])
+	require.ErrorContains(t, err, "does not match the genesis hash")
+	require.Empty(t, modified.accountKeeper.GetAllAccounts(ctx))
+}
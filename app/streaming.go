@@ -0,0 +1,62 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/bianjieai/irita/app/streaming"
+)
+
+// setStreamer creates the streamer of the configured sinks and adds it as listener
+// of the configured stores, all the mounted stores by default
+func (app *IritaApp) setStreamer(appOpts servertypes.AppOptions, homePath string) error {
+	config := streaming.ConfigFromAppOptions(appOpts, homePath)
+	if len(config.Sinks) == 0 {
+		return nil
+	}
+
+	stores := config.Stores
+	if len(stores) == 0 {
+		for _, name := range storeKeys {
+			if _, ok := app.keys[name]; ok {
+				stores = append(stores, name)
+			}
+		}
+	}
+	for _, name := range stores {
+		if _, ok := app.keys[name]; !ok {
+			return fmt.Errorf("cannot stream the unknown or disabled store %s", name)
+		}
+	}
+
+	sinks, err := config.NewSinks(app.Logger())
+	if err != nil {
+		return err
+	}
+	app.streamer = streaming.NewStreamer(sinks...)
+	app.streamHaltOnError = config.HaltOnError
+
+	// the streamer listens to the committed stores rather than to the multistore, whose
+	// listeners get the writes of every branch of the state. The committed stores are
+	// wrapped on top of the inter-block cache enabled by the node flag, which it replaces.
+	var interBlockCache storetypes.MultiStorePersistentCache
+	if cast.ToBool(appOpts.Get(server.FlagInterBlockCache)) {
+		interBlockCache = store.NewCommitKVStoreCacheManager()
+	}
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener)
+	for _, name := range stores {
+		listeners[app.keys[name]] = []storetypes.WriteListener{app.streamer}
+	}
+
+	// the commit multistore is only reachable through an uncached context
+	cms := app.NewUncachedContext(false, tmproto.Header{}).MultiStore().(sdk.CommitMultiStore)
+	cms.SetInterBlockCache(streaming.NewListenCache(interBlockCache, listeners))
+	return nil
+}
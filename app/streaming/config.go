@@ -0,0 +1,112 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// The app.toml options of the `[streaming]` section
+const (
+	FlagSinks        = "streaming.sinks"
+	FlagStores       = "streaming.stores"
+	FlagHaltOnError  = "streaming.halt-on-error"
+	FlagFileDir      = "streaming.file.dir"
+	FlagFileMaxSize  = "streaming.file.max-size"
+	FlagFileMaxFiles = "streaming.file.max-files"
+	FlagSocketAddr   = "streaming.socket.address"
+)
+
+// The sink names
+const (
+	SinkFile   = "file"
+	SinkSocket = "socket"
+)
+
+const (
+	defaultFileDir     = "data/streaming"
+	defaultFileMaxSize = 100 << 20
+)
+
+// AddFlags adds the streaming flags to the start command
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().StringSlice(FlagSinks, nil, fmt.Sprintf("Sinks streaming the committed blocks, among %s and %s", SinkFile, SinkSocket))
+	startCmd.Flags().StringSlice(FlagStores, nil, "Stores whose state changes are streamed, all the stores if empty")
+	startCmd.Flags().Bool(FlagHaltOnError, false, "Halt the node if a block cannot be streamed")
+	startCmd.Flags().String(FlagFileDir, defaultFileDir, "Directory of the file sink, relative to the node home")
+	startCmd.Flags().Int64(FlagFileMaxSize, defaultFileMaxSize, "Size in bytes from which the file sink starts a new file, no rotation if 0")
+	startCmd.Flags().Int(FlagFileMaxFiles, 0, "Number of files kept by the file sink, all the files if 0")
+	startCmd.Flags().String(FlagSocketAddr, "", "Address of the socket sink, unix:///path/to/socket or tcp://host:port")
+}
+
+// Config is the streaming configuration
+type Config struct {
+	Sinks        []string
+	Stores       []string
+	HaltOnError  bool
+	FileDir      string
+	FileMaxSize  int64
+	FileMaxFiles int
+	SocketAddr   string
+}
+
+// ConfigFromAppOptions reads the streaming configuration from the app options,
+// resolving the relative file sink directory against the node home
+func ConfigFromAppOptions(appOpts servertypes.AppOptions, homePath string) Config {
+	config := Config{
+		Sinks:        cast.ToStringSlice(appOpts.Get(FlagSinks)),
+		Stores:       cast.ToStringSlice(appOpts.Get(FlagStores)),
+		HaltOnError:  cast.ToBool(appOpts.Get(FlagHaltOnError)),
+		FileDir:      cast.ToString(appOpts.Get(FlagFileDir)),
+		FileMaxSize:  defaultFileMaxSize,
+		FileMaxFiles: cast.ToInt(appOpts.Get(FlagFileMaxFiles)),
+		SocketAddr:   cast.ToString(appOpts.Get(FlagSocketAddr)),
+	}
+
+	if maxSize := appOpts.Get(FlagFileMaxSize); maxSize != nil {
+		config.FileMaxSize = cast.ToInt64(maxSize)
+	}
+	if len(config.FileDir) == 0 {
+		config.FileDir = defaultFileDir
+	}
+	if !filepath.IsAbs(config.FileDir) {
+		config.FileDir = filepath.Join(homePath, config.FileDir)
+	}
+	return config
+}
+
+// NewSinks creates the configured sinks
+func (c Config) NewSinks(logger log.Logger) ([]Sink, error) {
+	var sinks []Sink
+	for _, name := range c.Sinks {
+		var (
+			sink Sink
+			err  error
+		)
+		switch name {
+		case SinkFile:
+			sink, err = NewFileSink(c.FileDir, c.FileMaxSize, c.FileMaxFiles)
+		case SinkSocket:
+			if len(c.SocketAddr) == 0 {
+				err = fmt.Errorf("the %s option is required by the %s sink", FlagSocketAddr, SinkSocket)
+				break
+			}
+			sink, err = NewSocketSink(c.SocketAddr, logger)
+		default:
+			err = fmt.Errorf("unknown streaming sink %s, expected %s or %s", name, SinkFile, SinkSocket)
+		}
+
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
@@ -0,0 +1,130 @@
+package streaming
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	fileSinkPrefix = "blocks-"
+	fileSinkSuffix = ".stream"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink writes the blocks to files named after the height of their first block.
+// A new file is started once the current one reaches the maximum size, and the
+// oldest files are removed beyond the maximum number of files.
+type FileSink struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink creates a FileSink writing to the given directory. The files are
+// rotated when reaching maxSize bytes if positive, and at most maxFiles are kept if
+// positive.
+func NewFileSink(dir string, maxSize int64, maxFiles int) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileSink{dir: dir, maxSize: maxSize, maxFiles: maxFiles}, nil
+}
+
+// WriteBlock implements Sink
+func (fs *FileSink) WriteBlock(height int64, data []byte) error {
+	if fs.file != nil && fs.maxSize > 0 && fs.size+int64(len(data)) > fs.maxSize {
+		if err := fs.file.Close(); err != nil {
+			return err
+		}
+		fs.file = nil
+	}
+
+	if fs.file == nil {
+		if err := fs.open(height); err != nil {
+			return err
+		}
+	}
+
+	n, err := fs.file.Write(data)
+	fs.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return fs.file.Sync()
+}
+
+// Close implements Sink
+func (fs *FileSink) Close() error {
+	if fs.file == nil {
+		return nil
+	}
+	err := fs.file.Close()
+	fs.file = nil
+	return err
+}
+
+// open starts the file of the given height and removes the oldest files
+func (fs *FileSink) open(height int64) error {
+	name := filepath.Join(fs.dir, fmt.Sprintf("%s%020d%s", fileSinkPrefix, height, fileSinkSuffix))
+	// the blocks of a restarted node are appended to the file of the same height
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	fs.file = file
+	fs.size = info.Size()
+	return fs.prune()
+}
+
+// prune removes the oldest files beyond the maximum number of files
+func (fs *FileSink) prune() error {
+	if fs.maxFiles <= 0 {
+		return nil
+	}
+
+	files, err := Files(fs.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > fs.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// Files returns the files written by a FileSink to the given directory, in the
+// order of their blocks
+func Files(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, fileSinkPrefix) && strings.HasSuffix(name, fileSinkSuffix) {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	// the heights are zero padded
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,100 @@
+package streaming
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ storetypes.MultiStorePersistentCache = (*ListenCache)(nil)
+
+// ListenCache wraps the committed stores of the listened keys, on top of the inter-block
+// cache if any, so that the listeners get the writes of the block state to the committed
+// stores on commit. Unlike the listeners of the multistore, which are called by every branch
+// of the state, the CheckTx, ReCheckTx and simulation ones included, it does not listen to
+// the branches, which are not committed.
+type ListenCache struct {
+	parent    storetypes.MultiStorePersistentCache
+	listeners map[storetypes.StoreKey][]storetypes.WriteListener
+	stores    map[storetypes.StoreKey]storetypes.CommitKVStore
+}
+
+// NewListenCache creates a ListenCache over the given inter-block cache, nil if none
+func NewListenCache(
+	parent storetypes.MultiStorePersistentCache, listeners map[storetypes.StoreKey][]storetypes.WriteListener,
+) *ListenCache {
+	return &ListenCache{
+		parent:    parent,
+		listeners: listeners,
+		stores:    make(map[storetypes.StoreKey]storetypes.CommitKVStore),
+	}
+}
+
+// GetStoreCache implements MultiStorePersistentCache
+func (c *ListenCache) GetStoreCache(key storetypes.StoreKey, store storetypes.CommitKVStore) storetypes.CommitKVStore {
+	c.stores[key] = store
+	if c.parent != nil {
+		store = c.parent.GetStoreCache(key, store)
+	}
+	if listeners, ok := c.listeners[key]; ok {
+		store = &listenStore{CommitKVStore: store, key: key, listeners: listeners}
+	}
+	return store
+}
+
+// Unwrap implements MultiStorePersistentCache
+func (c *ListenCache) Unwrap(key storetypes.StoreKey) storetypes.CommitKVStore {
+	return c.stores[key]
+}
+
+// Reset implements MultiStorePersistentCache
+func (c *ListenCache) Reset() {
+	if c.parent != nil {
+		c.parent.Reset()
+	}
+}
+
+// listenStore is a committed store calling the listeners on its writes. Its branches write
+// to it, so that the writes of the committed branches are listened to.
+type listenStore struct {
+	storetypes.CommitKVStore
+
+	key       storetypes.StoreKey
+	listeners []storetypes.WriteListener
+}
+
+// Set implements KVStore
+func (s *listenStore) Set(key, value []byte) {
+	s.CommitKVStore.Set(key, value)
+	s.onWrite(key, value, false)
+}
+
+// Delete implements KVStore
+func (s *listenStore) Delete(key []byte) {
+	s.CommitKVStore.Delete(key)
+	s.onWrite(key, nil, true)
+}
+
+// CacheWrap implements CacheWrapper
+func (s *listenStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper
+func (s *listenStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements CacheWrapper
+func (s *listenStore) CacheWrapWithListeners(key storetypes.StoreKey, listeners []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, key, listeners))
+}
+
+func (s *listenStore) onWrite(key, value []byte, delete bool) {
+	for _, l := range s.listeners {
+		_ = l.OnWrite(s.key, key, value, delete)
+	}
+}
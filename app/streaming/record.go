@@ -0,0 +1,120 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// RecordType identifies the message encoded in a record
+type RecordType byte
+
+const (
+	RecordBeginBlockRequest  RecordType = iota + 1 // abci.RequestBeginBlock
+	RecordBeginBlockResponse                       // abci.ResponseBeginBlock
+	RecordDeliverTxRequest                         // abci.RequestDeliverTx
+	RecordDeliverTxResponse                        // abci.ResponseDeliverTx
+	RecordEndBlockRequest                          // abci.RequestEndBlock
+	RecordEndBlockResponse                         // abci.ResponseEndBlock
+	RecordStoreKVPair                              // storetypes.StoreKVPair
+	RecordCommitResponse                           // abci.ResponseCommit, last record of a block
+)
+
+// Record is a streamed message, encoded as its type byte followed by the uvarint
+// length of the protobuf encoded message and the message itself
+type Record struct {
+	Type RecordType
+	Data []byte
+}
+
+// Message decodes the record message
+func (r Record) Message() (codec.ProtoMarshaler, error) {
+	var msg codec.ProtoMarshaler
+	switch r.Type {
+	case RecordBeginBlockRequest:
+		msg = &abci.RequestBeginBlock{}
+	case RecordBeginBlockResponse:
+		msg = &abci.ResponseBeginBlock{}
+	case RecordDeliverTxRequest:
+		msg = &abci.RequestDeliverTx{}
+	case RecordDeliverTxResponse:
+		msg = &abci.ResponseDeliverTx{}
+	case RecordEndBlockRequest:
+		msg = &abci.RequestEndBlock{}
+	case RecordEndBlockResponse:
+		msg = &abci.ResponseEndBlock{}
+	case RecordStoreKVPair:
+		msg = &storetypes.StoreKVPair{}
+	case RecordCommitResponse:
+		msg = &abci.ResponseCommit{}
+	default:
+		return nil, fmt.Errorf("unknown record type %d", r.Type)
+	}
+
+	if err := msg.Unmarshal(r.Data); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// marshaler is implemented by the protobuf messages
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// appendRecord appends the encoded record of the message to the buffer
+func appendRecord(buf []byte, t RecordType, msg marshaler) ([]byte, error) {
+	bz, err := msg.Marshal()
+	if err != nil {
+		return buf, err
+	}
+
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(bz)))
+
+	buf = append(buf, byte(t))
+	buf = append(buf, length[:n]...)
+	return append(buf, bz...), nil
+}
+
+// Reader reads the records of a stream
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader creates a Reader reading the records from the given stream
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read returns the next record, or io.EOF at the end of the stream
+func (r *Reader) Read() (Record, error) {
+	t, err := r.r.ReadByte()
+	if err != nil {
+		return Record{}, err
+	}
+
+	length, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return Record{}, unexpectedEOF(err)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return Record{}, unexpectedEOF(err)
+	}
+	return Record{Type: RecordType(t), Data: data}, nil
+}
+
+// unexpectedEOF reports a truncated record
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
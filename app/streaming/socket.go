@@ -0,0 +1,137 @@
+package streaming
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// socketClientBuffer is the number of blocks buffered for each client, the slower
+// clients are disconnected
+const socketClientBuffer = 100
+
+var _ Sink = (*SocketSink)(nil)
+
+// SocketSink streams the blocks to the clients connected to a unix or tcp socket.
+// The clients receive the blocks committed after they are connected, starting
+// with a BeginBlock request record.
+type SocketSink struct {
+	logger   log.Logger
+	listener net.Listener
+
+	mtx     sync.Mutex
+	clients map[net.Conn]chan []byte
+	closed  bool
+}
+
+// NewSocketSink creates a SocketSink listening on the given address, either
+// unix:///path/to/socket or tcp://host:port
+func NewSocketSink(address string, logger log.Logger) (*SocketSink, error) {
+	parts := strings.SplitN(address, "://", 2)
+	if len(parts) != 2 || (parts[0] != "unix" && parts[0] != "tcp") {
+		return nil, fmt.Errorf("invalid socket address %s, expected unix:///path/to/socket or tcp://host:port", address)
+	}
+
+	network, addr := parts[0], parts[1]
+	if network == "unix" {
+		// remove the socket left by a previous run
+		if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &SocketSink{
+		logger:   logger,
+		listener: listener,
+		clients:  make(map[net.Conn]chan []byte),
+	}
+	go ss.accept()
+	return ss, nil
+}
+
+// Addr returns the address the sink listens on
+func (ss *SocketSink) Addr() net.Addr {
+	return ss.listener.Addr()
+}
+
+// WriteBlock implements Sink
+func (ss *SocketSink) WriteBlock(height int64, data []byte) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	for conn, blocks := range ss.clients {
+		select {
+		case blocks <- data:
+		default:
+			ss.logger.Error("disconnecting slow streaming client", "client", conn.RemoteAddr(), "height", height)
+			ss.remove(conn)
+		}
+	}
+	return nil
+}
+
+// Close implements Sink
+func (ss *SocketSink) Close() error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	ss.closed = true
+	for conn := range ss.clients {
+		ss.remove(conn)
+	}
+	return ss.listener.Close()
+}
+
+// accept registers the connecting clients until the listener is closed
+func (ss *SocketSink) accept() {
+	for {
+		conn, err := ss.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		ss.mtx.Lock()
+		if ss.closed {
+			ss.mtx.Unlock()
+			_ = conn.Close()
+			return
+		}
+		blocks := make(chan []byte, socketClientBuffer)
+		ss.clients[conn] = blocks
+		ss.mtx.Unlock()
+
+		go ss.serve(conn, blocks)
+	}
+}
+
+// serve writes the blocks to the client until it is removed or disconnected
+func (ss *SocketSink) serve(conn net.Conn, blocks <-chan []byte) {
+	for data := range blocks {
+		if _, err := conn.Write(data); err != nil {
+			ss.mtx.Lock()
+			ss.remove(conn)
+			ss.mtx.Unlock()
+			return
+		}
+	}
+}
+
+// remove closes the client connection, the caller must hold the lock
+func (ss *SocketSink) remove(conn net.Conn) {
+	blocks, ok := ss.clients[conn]
+	if !ok {
+		return
+	}
+	delete(ss.clients, conn)
+	close(blocks)
+	_ = conn.Close()
+}
@@ -0,0 +1,143 @@
+// Package streaming streams the state changes and the ABCI messages of the committed
+// blocks to the configured sinks.
+//
+// Each block is streamed as a sequence of records (see Record): the BeginBlock request
+// and response, the DeliverTx request and response of each tx, the EndBlock request
+// and response, the KV pairs written to the listened stores and the Commit response.
+package streaming
+
+import (
+	"fmt"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Sink receives the records of each committed block
+type Sink interface {
+	// WriteBlock writes the encoded records of the block at the given height
+	WriteBlock(height int64, data []byte) error
+	// Close releases the sink resources
+	Close() error
+}
+
+var _ storetypes.WriteListener = (*Streamer)(nil)
+
+// Streamer collects the records of the current block and writes them to the sinks
+// on commit. It listens to the writes of the KV stores it is added to.
+//
+// The listened stores report the writes of every branch of the state, including the
+// CheckTx, ReCheckTx and simulation ones. Only the writes between BeginCommit and
+// ListenCommit, which flush the block state to the committed stores, are streamed.
+type Streamer struct {
+	mtx        sync.Mutex
+	height     int64
+	committing bool
+	buf        []byte
+	err        error
+	sinks      []Sink
+}
+
+// NewStreamer creates a Streamer writing to the given sinks
+func NewStreamer(sinks ...Sink) *Streamer {
+	return &Streamer{sinks: sinks}
+}
+
+// OnWrite implements WriteListener, streaming the writes of the block being committed
+func (s *Streamer) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.committing {
+		s.write(RecordStoreKVPair, &storetypes.StoreKVPair{
+			StoreKey: storeKey.Name(),
+			Delete:   delete,
+			Key:      key,
+			Value:    value,
+		})
+	}
+	return nil
+}
+
+// ListenBeginBlock streams the BeginBlock request and response, starting a new block
+func (s *Streamer) ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	s.mtx.Lock()
+	// the sinks may keep the buffer of the previous block
+	s.buf = nil
+	s.err = nil
+	s.height = req.Header.Height
+	s.mtx.Unlock()
+
+	s.append(RecordBeginBlockRequest, &req)
+	s.append(RecordBeginBlockResponse, &res)
+}
+
+// ListenDeliverTx streams the DeliverTx request and response
+func (s *Streamer) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	s.append(RecordDeliverTxRequest, &req)
+	s.append(RecordDeliverTxResponse, &res)
+}
+
+// ListenEndBlock streams the EndBlock request and response
+func (s *Streamer) ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	s.append(RecordEndBlockRequest, &req)
+	s.append(RecordEndBlockResponse, &res)
+}
+
+// BeginCommit starts streaming the writes, before the block state is committed
+func (s *Streamer) BeginCommit() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.committing = true
+}
+
+// ListenCommit stops streaming the writes, then streams the Commit response and writes
+// the block to all the sinks
+func (s *Streamer) ListenCommit(res abci.ResponseCommit) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.committing = false
+	s.write(RecordCommitResponse, &res)
+
+	if s.err != nil {
+		return fmt.Errorf("failed to encode the block %d: %w", s.height, s.err)
+	}
+	for _, sink := range s.sinks {
+		if err := sink.WriteBlock(s.height, s.buf); err != nil {
+			return fmt.Errorf("failed to stream the block %d: %w", s.height, err)
+		}
+	}
+	return nil
+}
+
+// Close closes all the sinks
+func (s *Streamer) Close() error {
+	var firstErr error
+	for _, sink := range s.sinks {
+		if err := sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// append appends the record to the current block
+func (s *Streamer) append(t RecordType, msg marshaler) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.write(t, msg)
+}
+
+// write appends the record to the current block, keeping the first encoding error. The
+// caller holds the lock.
+func (s *Streamer) write(t RecordType, msg marshaler) {
+	buf, err := appendRecord(s.buf, t, msg)
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	s.buf = buf
+}
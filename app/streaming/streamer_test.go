@@ -0,0 +1,113 @@
+package streaming
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// streamBlock streams a block with a tx and a state change, the writes out of the commit
+// being discarded
+func streamBlock(t *testing.T, s *Streamer, height int64) {
+	s.ListenBeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{})
+	s.ListenDeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{Code: 1})
+	require.NoError(t, s.OnWrite(storetypes.NewKVStoreKey("bank"), []byte("key"), []byte("uncommitted"), false))
+	s.ListenEndBlock(abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{})
+	s.BeginCommit()
+	require.NoError(t, s.OnWrite(storetypes.NewKVStoreKey("bank"), []byte("key"), []byte("value"), false))
+	require.NoError(t, s.ListenCommit(abci.ResponseCommit{Data: []byte("hash")}))
+}
+
+// readBlock reads a block streamed by streamBlock
+func readBlock(t *testing.T, r *Reader, height int64) {
+	expected := []RecordType{
+		RecordBeginBlockRequest, RecordBeginBlockResponse,
+		RecordDeliverTxRequest, RecordDeliverTxResponse,
+		RecordEndBlockRequest, RecordEndBlockResponse,
+		RecordStoreKVPair, RecordCommitResponse,
+	}
+	for _, recordType := range expected {
+		record, err := r.Read()
+		require.NoError(t, err)
+		require.Equal(t, recordType, record.Type)
+
+		msg, err := record.Message()
+		require.NoError(t, err)
+		switch msg := msg.(type) {
+		case *abci.RequestBeginBlock:
+			require.Equal(t, height, msg.Header.Height)
+		case *abci.ResponseDeliverTx:
+			require.Equal(t, uint32(1), msg.Code)
+		case *storetypes.StoreKVPair:
+			require.Equal(t, "bank", msg.StoreKey)
+			require.Equal(t, []byte("value"), msg.Value)
+		}
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir, 200, 2)
+	require.NoError(t, err)
+
+	s := NewStreamer(sink)
+	for height := int64(1); height <= 5; height++ {
+		streamBlock(t, s, height)
+	}
+	require.NoError(t, s.Close())
+
+	// a block is about 100 bytes, so each file holds 1 or 2 blocks and the oldest are removed
+	files, err := Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Equal(t, "blocks-00000000000000000003.stream", filepath.Base(files[0]))
+	require.Equal(t, "blocks-00000000000000000005.stream", filepath.Base(files[1]))
+
+	file, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer file.Close()
+
+	r := NewReader(file)
+	readBlock(t, r, 3)
+	readBlock(t, r, 4)
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestSocketSink(t *testing.T) {
+	sink, err := NewSocketSink("unix://"+filepath.Join(t.TempDir(), "streaming.sock"), log.NewNopLogger())
+	require.NoError(t, err)
+
+	s := NewStreamer(sink)
+	defer s.Close()
+
+	conn, err := net.Dial(sink.Addr().Network(), sink.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// wait for the client to be registered
+	require.Eventually(t, func() bool {
+		sink.mtx.Lock()
+		defer sink.mtx.Unlock()
+		return len(sink.clients) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	streamBlock(t, s, 1)
+	streamBlock(t, s, 2)
+
+	r := NewReader(conn)
+	readBlock(t, r, 1)
+	readBlock(t, r, 2)
+
+	_, err = NewSocketSink("udp://localhost:0", log.NewNopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/bianjieai/irita/app/streaming"
+)
+
+func TestStreaming(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := mapAppOptions{
+		streaming.FlagSinks:   []string{streaming.SinkFile},
+		streaming.FlagStores:  []string{banktypes.StoreKey},
+		streaming.FlagFileDir: dir,
+		// the fee market BeginBlocker requires the consensus params
+		FlagDisabledModules: []string{"evm"},
+	}
+	app := NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), appOpts)
+	require.NoError(t, setGenesis(app))
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, ChainID: "irita_1000-1"}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	files, err := streaming.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	file, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer file.Close()
+
+	// the file holds the genesis state, committed without BeginBlock, and the block
+	var stores = make(map[string]bool)
+	var types []streaming.RecordType
+	r := streaming.NewReader(file)
+	for {
+		record, err := r.Read()
+		if err != nil {
+			break
+		}
+		types = append(types, record.Type)
+
+		if record.Type == streaming.RecordStoreKVPair {
+			msg, err := record.Message()
+			require.NoError(t, err)
+			stores[msg.(*storetypes.StoreKVPair).StoreKey] = true
+		}
+	}
+
+	require.Equal(t, map[string]bool{banktypes.StoreKey: true}, stores, "only the bank state changes are streamed")
+	require.Equal(t, streaming.RecordCommitResponse, types[len(types)-1])
+	require.Contains(t, types, streaming.RecordBeginBlockRequest)
+	require.Contains(t, types, streaming.RecordEndBlockResponse)
+}
+
+func TestStreamingCheckTx(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := mapAppOptions{
+		streaming.FlagSinks:        []string{streaming.SinkFile},
+		streaming.FlagStores:       []string{authtypes.StoreKey},
+		streaming.FlagFileDir:      dir,
+		server.FlagInterBlockCache: true,
+		// the fee market BeginBlocker requires the consensus params
+		FlagDisabledModules: []string{"evm"},
+	}
+	app := NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), appOpts)
+	require.NoError(t, setGenesis(app))
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	accountKey := authtypes.AddressStoreKey(addr)
+
+	// the account is created in block 2
+	account := createAccount(app, priv)
+	header := tmproto.Header{Height: app.LastBlockHeight(), ChainID: "irita_1000-1"}
+	require.NotNil(t, app.accountKeeper.GetAccount(app.BaseApp.NewUncachedContext(false, header), addr), "the listened stores are committed")
+
+	// the account is updated by a CheckTx in block 3
+	header.Height++
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("upoint", 1)))
+	res := app.CheckTx(abci.RequestCheckTx{Tx: signTx(t, header.ChainID, priv, account.GetAccountNumber(), 0, msg)})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	require.Equal(t, uint64(1), app.accountKeeper.GetAccount(app.BaseApp.NewContext(true, header), addr).GetSequence())
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the writes of the CheckTx are not streamed
+	writes := streamedWrites(t, dir)
+	written := func(height int64) bool {
+		for _, pair := range writes[height] {
+			if bytes.Equal(accountKey, pair.Key) {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(t, written(2))
+	require.False(t, written(3))
+}
+
+// streamedWrites returns the KV pairs streamed to the files of the directory by height,
+// the genesis state being at height 0
+func streamedWrites(t *testing.T, dir string) map[int64][]*storetypes.StoreKVPair {
+	files, err := streaming.Files(dir)
+	require.NoError(t, err)
+
+	writes := make(map[int64][]*storetypes.StoreKVPair)
+	for _, name := range files {
+		file, err := os.Open(name)
+		require.NoError(t, err)
+		defer file.Close()
+
+		var height int64
+		r := streaming.NewReader(file)
+		for {
+			record, err := r.Read()
+			if err != nil {
+				break
+			}
+			msg, err := record.Message()
+			require.NoError(t, err)
+
+			switch msg := msg.(type) {
+			case *abci.RequestBeginBlock:
+				height = msg.Header.Height
+			case *storetypes.StoreKVPair:
+				writes[height] = append(writes[height], msg)
+			}
+		}
+	}
+	return writes
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	erc20types "github.com/bianjieai/irita/modules/erc20/types"
+	feeabstypes "github.com/bianjieai/irita/modules/feeabs/types"
+	msgfiltertypes "github.com/bianjieai/irita/modules/msgfilter/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	proposaltypes "github.com/bianjieai/irita/modules/proposal/types"
+	ratelimittypes "github.com/bianjieai/irita/modules/ratelimit/types"
+	sm2types "github.com/bianjieai/irita/modules/sm2/types"
+	fttransfertypes "github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+// Upgrade defines a named software upgrade, applied at the height of the upgrade
+// plan with the same name. The module migrations run after the custom handler, in
+// the module manager migrations order.
+type Upgrade struct {
+	// Name is the name of the upgrade plan
+	Name string
+	// StoreUpgrades are the stores added, renamed and deleted at the upgrade height
+	StoreUpgrades store.StoreUpgrades
+	// AddedModules are the modules added by the upgrade, initialized with their
+	// default genesis by the module migrations
+	AddedModules []string
+	// Handler optionally runs the custom upgrade logic before the module migrations
+	Handler func(ctx sdk.Context, app *IritaApp, plan sdkupgrade.Plan) error
+}
+
+// upgrades are the known upgrades, in the order they were released.
+// The upgrades are never removed so that the nodes can sync from any height.
+var upgrades = []Upgrade{
+	{
+		// v5.0.0 adds the modules introduced since v4.0.0
+		Name: "v5.0.0",
+		StoreUpgrades: store.StoreUpgrades{
+			Added: []string{
+				capabilitytypes.StoreKey,
+				authzkeeper.StoreKey,
+				coinswaptypes.StoreKey,
+				permtypes.StoreKey,
+				proposaltypes.StoreKey,
+				feeabstypes.StoreKey,
+				ratelimittypes.StoreKey,
+				fttransfertypes.StoreKey,
+				erc20types.StoreKey,
+			},
+		},
+		AddedModules: []string{
+			capabilitytypes.ModuleName,
+			authz.ModuleName,
+			coinswaptypes.ModuleName,
+			permtypes.ModuleName,
+			proposaltypes.ModuleName,
+			feeabstypes.ModuleName,
+			ratelimittypes.ModuleName,
+			msgfiltertypes.ModuleName,
+			sm2types.ModuleName,
+			fttransfertypes.ModuleName,
+			erc20types.ModuleName,
+		},
+		Handler: seedRootAdmins,
+	},
+}
+
+// v5UpgradeInfo is the JSON info of the v5.0.0 upgrade plan
+type v5UpgradeInfo struct {
+	// RootAdmins are granted the root admin role of the added perm module
+	RootAdmins []string `json:"root_admins"`
+}
+
+// seedRootAdmins grants the root admin role to the accounts listed by the info of
+// the plan, e.g. {"root_admins":["iaa1..."]}. The perm module added by v5.0.0 has
+// no role account otherwise, so that no role could ever be assigned.
+func seedRootAdmins(ctx sdk.Context, app *IritaApp, plan sdkupgrade.Plan) error {
+	var info v5UpgradeInfo
+	if err := json.Unmarshal([]byte(plan.Info), &info); err != nil {
+		return fmt.Errorf("invalid info of the %s upgrade plan: %w", plan.Name, err)
+	}
+	if len(info.RootAdmins) == 0 {
+		return fmt.Errorf("the info of the %s upgrade plan lists no root admin", plan.Name)
+	}
+
+	for _, admin := range info.RootAdmins {
+		address, err := permtypes.AccAddressFromString(admin)
+		if err != nil {
+			return fmt.Errorf("invalid root admin %s: %w", admin, err)
+		}
+		app.permKeeper.SetRole(ctx, address, permtypes.RoleRootAdmin)
+	}
+	return nil
+}
+
+// RegisterUpgrades adds the upgrades to the registry. It must be called before
+// creating the app, e.g. by the plugins adding their own upgrades.
+func RegisterUpgrades(us ...Upgrade) {
+	for _, u := range us {
+		if len(u.Name) == 0 {
+			panic("upgrade name cannot be empty")
+		}
+		if _, ok := GetUpgrade(u.Name); ok {
+			panic(fmt.Sprintf("upgrade %s already registered", u.Name))
+		}
+		upgrades = append(upgrades, u)
+	}
+}
+
+// Upgrades returns the known upgrades, in the order they were registered
+func Upgrades() []Upgrade {
+	return append([]Upgrade{}, upgrades...)
+}
+
+// GetUpgrade returns the upgrade with the given name
+func GetUpgrade(name string) (Upgrade, bool) {
+	for _, u := range upgrades {
+		if u.Name == name {
+			return u, true
+		}
+	}
+	return Upgrade{}, false
+}
+
+// registerUpgrades sets the handlers of all the known upgrades, and the store
+// loader of the upgrade written to disk by the previous binary if any
+func (app *IritaApp) registerUpgrades() {
+	for _, u := range upgrades {
+		app.upgradeKeeper.SetUpgradeHandler(u.Name, app.upgradeHandler(u))
+	}
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		app.Logger().Info("not found upgrade plan", "err", err.Error())
+		return
+	}
+
+	if u, ok := GetUpgrade(upgradeInfo.Name); ok && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		// configure store loader that checks if version+1 == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(sdkupgrade.UpgradeStoreLoader(upgradeInfo.Height, &u.StoreUpgrades))
+	}
+}
+
+// upgradeHandler returns the handler running the custom logic and the module
+// migrations of the upgrade
+func (app *IritaApp) upgradeHandler(u Upgrade) sdkupgrade.UpgradeHandler {
+	return func(ctx sdk.Context, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if u.Handler != nil {
+			if err := u.Handler(ctx, app, plan); err != nil {
+				return nil, err
+			}
+		}
+
+		added := make(map[string]bool)
+		for _, name := range u.AddedModules {
+			added[name] = true
+		}
+
+		// the chains started before the module versions were stored have no version,
+		// the existing modules are then considered up to date
+		vm := make(module.VersionMap)
+		for name, version := range fromVM {
+			vm[name] = version
+		}
+		for name, m := range app.mm.Modules {
+			if _, ok := vm[name]; !ok && !added[name] {
+				vm[name] = m.ConsensusVersion()
+			}
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, vm)
+	}
+}
+
+// DryRunUpgrade loads the latest version of the app, applies the given upgrade at
+// the next height and commits it, returning the upgrade height and the resulting app
+// hash. The block transactions are not executed. The app must be created without
+// loading the latest version, on a copy of the application db.
+// The plan info is the given one if any, or else the one of the scheduled plan.
+func (app *IritaApp) DryRunUpgrade(name, info string) (height int64, hash []byte, err error) {
+	u, ok := GetUpgrade(name)
+	if !ok {
+		return 0, nil, fmt.Errorf("unknown upgrade %s", name)
+	}
+
+	// keep the multistore to commit the upgrade
+	var cms sdk.CommitMultiStore
+	app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+		cms = ms
+		return ms.LoadLatestVersionAndUpgrade(&u.StoreUpgrades)
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		return 0, nil, err
+	}
+	height = app.LastBlockHeight() + 1
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade %s failed: %v", name, r)
+		}
+	}()
+
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: height})
+	plan := sdkupgrade.Plan{Name: name, Height: height, Info: info}
+	if scheduled, found := app.upgradeKeeper.UpgradeKeeper().GetUpgradePlan(ctx); found && scheduled.Name == name && info == "" {
+		plan.Info = scheduled.Info
+	}
+	app.upgradeKeeper.UpgradeKeeper().ApplyUpgrade(ctx, plan)
+
+	return height, cms.Commit().Hash, nil
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	iapp := NewIritaApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{}, interBlockCacheOpt())
+	require.NoError(t, setGenesis(iapp))
+
+	var appliedHeight int64
+	v5, _ := GetUpgrade("v5.0.0")
+	RegisterUpgrades(Upgrade{
+		Name: "test-upgrade",
+		Handler: func(ctx sdk.Context, app *IritaApp, plan sdkupgrade.Plan) error {
+			appliedHeight = plan.Height
+			return nil
+		},
+	}, Upgrade{
+		// the stores added by v5.0.0 already exist
+		Name:    "test-v5.0.0",
+		Handler: v5.Handler,
+	})
+	defer func() { upgrades = upgrades[:len(upgrades)-2] }()
+
+	require.Panics(t, func() { RegisterUpgrades(Upgrade{Name: "test-upgrade"}) }, "the upgrade names are unique")
+
+	iapp = NewIritaApp(log.NewNopLogger(), db, nil, false, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	height, hash, err := iapp.DryRunUpgrade("test-upgrade", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+	require.Equal(t, int64(2), appliedHeight)
+	require.NotEmpty(t, hash)
+
+	iapp = NewIritaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	_, _, err = iapp.DryRunUpgrade("unknown", "")
+	require.Error(t, err)
+
+	// v5.0.0 seeds the root admins of the plan info, which must list at least one
+	rootAdmin := sdk.AccAddress(tmhash.SumTruncated([]byte("rootAdmin")))
+	for _, info := range []string{"", "{}", `{"root_admins":["invalid"]}`} {
+		iapp = NewIritaApp(log.NewNopLogger(), db, nil, false, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+		_, _, err = iapp.DryRunUpgrade("test-v5.0.0", info)
+		require.Error(t, err, info)
+	}
+
+	iapp = NewIritaApp(log.NewNopLogger(), db, nil, false, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	height, _, err = iapp.DryRunUpgrade("test-v5.0.0", `{"root_admins":["`+rootAdmin.String()+`"]}`)
+	require.NoError(t, err)
+
+	ctx := iapp.NewContext(true, tmproto.Header{Height: height})
+	require.True(t, iapp.permKeeper.HasRole(ctx, rootAdmin, permtypes.RoleRootAdmin))
+}
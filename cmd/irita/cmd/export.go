@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/bianjieai/irita/app"
+)
+
+const (
+	flagModules = "modules"
+
+	streamedGenesisFile  = "genesis.json"
+	streamedAppStateFile = "app_state.json"
+)
+
+// ExportStreamCmd exports the state to a genesis file whose module states are
+// streamed to a separate file, one module at a time
+func ExportStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-stream [output-dir]",
+		Short: "export the state to a genesis whose module states are streamed to a separate file",
+		Long: fmt.Sprintf(`Export the state to the %s and %s files of the output directory, without holding the whole state in memory.
+The module states are written one module at a time to %s, referenced by the app state of %s with its hash.
+Copy both files to the config directory of the nodes, the modules are initialized from %s as it is read, once its hash is checked.
+Only the state of one module is held in memory at a time: the largest module state, such as the nft or evm one, must still fit in memory.`,
+			streamedGenesisFile, streamedAppStateFile, streamedAppStateFile, streamedGenesisFile, streamedAppStateFile,
+		),
+		Example: fmt.Sprintf(
+			"$ %s export-stream ./export --modules=auth,bank,nft --home=/root/.%s",
+			version.AppName, version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			outputDir := args[0]
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+			modules, _ := cmd.Flags().GetStringSlice(flagModules)
+
+			appDB := loadDb(applicationDBDir, filepath.Join(config.RootDir, dataDir))
+			defer appDB.Close()
+
+			iritaApp := app.NewIritaApp(
+				log.NewNopLogger(), appDB, nil, height == -1, map[int64]bool{}, config.RootDir, 0,
+				app.MakeEncodingConfig(), serverCtx.Viper,
+			)
+			if height != -1 {
+				if err := iritaApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			exported, err := iritaApp.ExportAppStateToFile(
+				forZeroHeight, jailAllowedAddrs, modules, filepath.Join(outputDir, streamedAppStateFile),
+			)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
+
+			doc.AppState = exported.AppState
+			doc.Validators = exported.Validators
+			doc.InitialHeight = exported.Height
+			doc.ConsensusParams = &tmproto.ConsensusParams{
+				Block: tmproto.BlockParams{
+					MaxBytes:   exported.ConsensusParams.Block.MaxBytes,
+					MaxGas:     exported.ConsensusParams.Block.MaxGas,
+					TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
+				},
+				Evidence: tmproto.EvidenceParams{
+					MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+					MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+					MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+				},
+				Validator: tmproto.ValidatorParams{
+					PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+				},
+			}
+
+			encoded, err := tmjson.Marshal(doc)
+			if err != nil {
+				return err
+			}
+			genesisFile := filepath.Join(outputDir, streamedGenesisFile)
+			if err := os.WriteFile(genesisFile, sdk.MustSortJSON(encoded), 0o644); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "state exported to %s and %s\n", genesisFile, filepath.Join(outputDir, streamedAppStateFile))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(server.FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(server.FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().StringSlice(flagModules, []string{}, "Comma-separated list of the modules to export, all the modules if empty")
+	return cmd
+}
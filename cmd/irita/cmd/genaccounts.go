@@ -19,6 +19,8 @@ import (
 	"github.com/spf13/cobra"
 	evmhd "github.com/tharsis/ethermint/crypto/hd"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
 )
 
 const (
@@ -160,6 +162,17 @@ func AddGenesisAccountCmd(defaultNodeHome string, defaultCliHome string) *cobra.
 
 			appState[banktypes.ModuleName] = bankGenStateBz
 
+			if rootAdmin, _ := cmd.Flags().GetBool(flagRootAdmin); rootAdmin {
+				var permGenState permtypes.GenesisState
+				cdc.MustUnmarshalJSON(appState[permtypes.ModuleName], &permGenState)
+
+				permGenState.RoleAccounts = append(
+					permGenState.RoleAccounts,
+					permtypes.NewRoleAccount(addr.String(), []permtypes.Role{permtypes.RoleRootAdmin}),
+				)
+				appState[permtypes.ModuleName] = cdc.MustMarshalJSON(&permGenState)
+			}
+
 			//evm config
 			var evmGenState evmtypes.GenesisState
 			cdc.MustUnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState)
@@ -34,9 +34,12 @@ import (
 	ethermint "github.com/tharsis/ethermint/types"
 
 	"github.com/bianjieai/irita/app"
+	"github.com/bianjieai/irita/app/indexer"
+	"github.com/bianjieai/irita/app/streaming"
 	evmclient "github.com/bianjieai/irita/modules/evm/client"
 	evmserver "github.com/bianjieai/irita/modules/evm/server"
 	evmutils "github.com/bianjieai/irita/modules/evm/utils"
+	"github.com/bianjieai/irita/modules/ratelimit"
 	genutilcli "github.com/bianjieai/iritamod/modules/genutil/client/cli"
 	"github.com/bianjieai/iritamod/modules/node"
 )
@@ -114,6 +117,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		debug.Cmd(),
 		config.Cmd(),
 		NewSnapshotCmd(),
+		NewUpgradeCmd(),
+		ExportStreamCmd(),
 	)
 
 	ac := appCreator{encodingConfig}
@@ -136,6 +141,10 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	app.AddModuleInitFlags(startCmd)
+	streaming.AddFlags(startCmd)
+	indexer.AddFlags(startCmd)
+	ratelimit.AddFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
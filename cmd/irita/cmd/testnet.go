@@ -41,6 +41,7 @@ import (
 	evmfmttypes "github.com/tharsis/ethermint/x/feemarket/types"
 
 	evmutils "github.com/bianjieai/irita/modules/evm/utils"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
 	"github.com/bianjieai/iritamod/modules/genutil"
 	"github.com/bianjieai/iritamod/modules/node"
 	"github.com/bianjieai/iritamod/utils"
@@ -398,6 +399,16 @@ func initGenFiles(
 	tokenGenState.Params.IssueTokenBaseFee = sdk.NewCoin(DefaultPointDenom, sdk.NewInt(60000))
 	appGenState[tokentypes.ModuleName] = jsonMarshaler.MustMarshalJSON(&tokenGenState)
 
+	// set the first account as the root admin
+	var permGenState permtypes.GenesisState
+	jsonMarshaler.MustUnmarshalJSON(appGenState[permtypes.ModuleName], &permGenState)
+
+	permGenState.RoleAccounts = append(
+		permGenState.RoleAccounts,
+		permtypes.NewRoleAccount(genAccounts[0].GetAddress().String(), []permtypes.Role{permtypes.RoleRootAdmin}),
+	)
+	appGenState[permtypes.ModuleName] = jsonMarshaler.MustMarshalJSON(&permGenState)
+
 	// modify the constant fee denoms in the crisis genesis
 	var crisisGenState crisistypes.GenesisState
 	jsonMarshaler.MustUnmarshalJSON(appGenState[crisistypes.ModuleName], &crisisGenState)
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/bianjieai/irita/app"
+	"github.com/bianjieai/irita/app/indexer"
+	"github.com/bianjieai/irita/app/streaming"
+)
+
+const (
+	flagInfo = "info"
+)
+
+// NewUpgradeCmd returns the commands inspecting the known upgrades
+func NewUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "inspect the software upgrades known by this binary",
+	}
+	cmd.AddCommand(
+		UpgradePlansCmd(),
+		UpgradeDryRunCmd(),
+	)
+	return cmd
+}
+
+// UpgradePlansCmd lists the known upgrades
+func UpgradePlansCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plans",
+		Short: "list the upgrade plans known by this binary, in the order they were released",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			for _, u := range app.Upgrades() {
+				fmt.Fprintln(out, u.Name)
+				if len(u.StoreUpgrades.Added) > 0 {
+					fmt.Fprintf(out, "  added stores: %s\n", strings.Join(u.StoreUpgrades.Added, ", "))
+				}
+				for _, r := range u.StoreUpgrades.Renamed {
+					fmt.Fprintf(out, "  renamed store: %s -> %s\n", r.OldKey, r.NewKey)
+				}
+				if len(u.StoreUpgrades.Deleted) > 0 {
+					fmt.Fprintf(out, "  deleted stores: %s\n", strings.Join(u.StoreUpgrades.Deleted, ", "))
+				}
+				if len(u.AddedModules) > 0 {
+					fmt.Fprintf(out, "  added modules: %s\n", strings.Join(u.AddedModules, ", "))
+				}
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// UpgradeDryRunCmd applies an upgrade on a copy of the application db
+func UpgradeDryRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [plan-name]",
+		Short: "apply the upgrade plan at the next height on a copy of the application db and print the resulting app hash",
+		Long: `Apply the upgrade plan at the next height on a copy of the application db and print the resulting app hash.
+The node must be stopped. Only the upgrade is applied, the block transactions are not executed.
+The streaming and the indexing configured for the node are disabled.
+The plan info defaults to the info of the scheduled plan of the same name.`,
+		Example: fmt.Sprintf(
+			"$ %s upgrade dry-run v5.0.0 --info='{\"root_admins\":[\"iaa1...\"]}' --home=/root/.%s",
+			version.AppName, version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, ok := app.GetUpgrade(args[0]); !ok {
+				return fmt.Errorf("unknown upgrade %s, see the plans command", args[0])
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			tmpDir, err := os.MkdirTemp("", "irita-upgrade-dry-run")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+
+			appDir := filepath.Join(home, dataDir, applicationDb)
+			if err := copyDir(appDir, filepath.Join(tmpDir, applicationDb)); err != nil {
+				return fmt.Errorf("copy %s err: %w", appDir, err)
+			}
+
+			appDB := loadDb(applicationDBDir, tmpDir)
+			defer appDB.Close()
+
+			appOpts, err := dryRunAppOptions(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			iritaApp := app.NewIritaApp(
+				log.NewNopLogger(), appDB, nil, false, map[int64]bool{}, home, 0,
+				app.MakeEncodingConfig(), appOpts,
+			)
+			info, _ := cmd.Flags().GetString(flagInfo)
+			height, hash, err := iritaApp.DryRunUpgrade(args[0], info)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "upgrade %s applied at height %d, app hash: %X\n", args[0], height, hash)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagInfo, "", "the info of the upgrade plan, e.g. the root admins seeded by v5.0.0")
+	return cmd
+}
+
+// dryRunAppOptions copies the node options, disabling the streaming and the indexing of
+// the node so that the dry-run app neither writes to the sinks nor to the indexer db
+func dryRunAppOptions(nodeOpts *viper.Viper) (*viper.Viper, error) {
+	appOpts := viper.New()
+	if err := appOpts.MergeConfigMap(nodeOpts.AllSettings()); err != nil {
+		return nil, err
+	}
+	appOpts.Set(streaming.FlagSinks, []string{})
+	appOpts.Set(indexer.FlagDriver, "")
+	return appOpts, nil
+}
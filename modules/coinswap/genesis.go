@@ -0,0 +1,18 @@
+package coinswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	evmtypes "github.com/bianjieai/irita/modules/evm/types"
+)
+
+// DefaultGenesisState returns the default coinswap genesis state, pairing the
+// liquidity pools with the EVM denom and charging the pool creation fee in it
+func DefaultGenesisState() *coinswaptypes.GenesisState {
+	gs := coinswaptypes.DefaultGenesisState()
+	gs.StandardDenom = evmtypes.DefaultEvmDenom
+	gs.Params.PoolCreationFee = sdk.NewCoin(evmtypes.DefaultEvmDenom, gs.Params.PoolCreationFee.Amount)
+	return gs
+}
@@ -0,0 +1,61 @@
+package coinswap
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/irisnet/irismod/modules/coinswap"
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the coinswap module.
+// It extends the irismod coinswap module with the IRITA default genesis state.
+type AppModuleBasic struct {
+	coinswap.AppModuleBasic
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the coinswap module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ____________________________________________________________________________
+
+// AppModule implements an application module for the coinswap module.
+type AppModule struct {
+	coinswap.AppModule
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(
+	cdc codec.Codec,
+	keeper keeper.Keeper,
+	accountKeeper coinswaptypes.AccountKeeper,
+	bankKeeper coinswaptypes.BankKeeper,
+) AppModule {
+	return AppModule{
+		AppModule: coinswap.NewAppModule(cdc, keeper, accountKeeper, bankKeeper),
+	}
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the coinswap module.
+func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return AppModuleBasic{}.DefaultGenesis(cdc)
+}
+
+// ____________________________________________________________________________
+
+// GenerateGenesisState creates a GenState of the coinswap module pairing the
+// liquidity pools with the bond denom held by the simulation accounts.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simState.GenState[coinswaptypes.ModuleName] = simState.Cdc.MustMarshalJSON(coinswaptypes.DefaultGenesisState())
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+// GetQueryCmd returns the query commands for the erc20 module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ERC20 conversion module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryTokenPair(),
+		GetCmdQueryTokenPairs(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryTokenPair implements the query token pair command.
+func GetCmdQueryTokenPair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token-pair [denom|erc20-address]",
+		Short:   "Query the token pair of a denom or an ERC20 contract",
+		Example: "$ irita query erc20 token-pair ubiz",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TokenPair(context.Background(), &types.QueryTokenPairRequest{Token: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.TokenPair)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenPairs implements the query token pairs command.
+func GetCmdQueryTokenPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token-pairs",
+		Short:   "Query the registered token pairs",
+		Example: "$ irita query erc20 token-pairs",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TokenPairs(context.Background(), &types.QueryTokenPairsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pairs")
+
+	return cmd
+}
@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+// NewTxCmd returns the transaction commands for the erc20 module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ERC20 conversion transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterCoinCmd(),
+		NewRegisterERC20Cmd(),
+		NewToggleConversionCmd(),
+		NewConvertCoinCmd(),
+		NewConvertERC20Cmd(),
+	)
+
+	return txCmd
+}
+
+// NewRegisterCoinCmd implements the register coin command.
+func NewRegisterCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-coin [denom]",
+		Short: "Deploy the ERC20 contract of a token",
+		Long: `Deploy the ERC20 contract of the token of the given min unit, owned by the module, and register the token pair.
+The name, symbol and decimals of the contract are the name, symbol and scale of the token.`,
+		Example: "$ irita tx erc20 register-coin ubiz --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterCoin(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterERC20Cmd implements the register erc20 command.
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [erc20-address]",
+		Short: "Register the token pair of an ERC20 contract",
+		Long: fmt.Sprintf(`Register the token pair of an external ERC20 contract.
+The native coins of the contract, of denom %s<erc20-address>, are the vouchers of the tokens escrowed by the module.`, types.ERC20DenomPrefix),
+		Example: "$ irita tx erc20 register-erc20 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterERC20(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewToggleConversionCmd implements the toggle conversion command.
+func NewToggleConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "toggle-conversion [denom|erc20-address]",
+		Short:   "Enable or disable the conversions of a token pair",
+		Example: "$ irita tx erc20 toggle-conversion ubiz --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgToggleConversion(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewConvertCoinCmd implements the convert coin command.
+func NewConvertCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-coin [coin] [receiver-hex-address]",
+		Short:   "Convert native coins to ERC20 tokens",
+		Long:    "Convert native coins to the ERC20 tokens of the receiver, the sender if not given.",
+		Example: "$ irita tx erc20 convert-coin 1000ubiz 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd --from=<key-name>",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+			receiver := common.BytesToAddress(sender)
+			if len(args) == 2 {
+				if !common.IsHexAddress(args[1]) {
+					return fmt.Errorf("invalid receiver address: %s", args[1])
+				}
+				receiver = common.HexToAddress(args[1])
+			}
+
+			msg := types.NewMsgConvertCoin(coin, receiver, sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewConvertERC20Cmd implements the convert erc20 command.
+func NewConvertERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-erc20 [erc20-address] [amount] [receiver]",
+		Short:   "Convert ERC20 tokens to native coins",
+		Long:    "Convert ERC20 tokens to the native coins of the receiver, the sender if not given.",
+		Example: "$ irita tx erc20 convert-erc20 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd 1000 iaa1... --from=<key-name>",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid ERC20 address: %s", args[0])
+			}
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			sender := clientCtx.GetFromAddress()
+			receiver := sender
+			if len(args) == 3 {
+				if receiver, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgConvertERC20(common.HexToAddress(args[0]), amount, receiver, common.BytesToAddress(sender))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,28 @@
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/erc20/keeper"
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+// InitGenesis stores the genesis token pairs
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	// create the module account which owns the ERC20 contracts if it does not exist,
+	// before the EVM creates a plain account at its address
+	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, pair := range data.TokenPairs {
+		k.SetTokenPair(ctx, pair)
+	}
+}
+
+// ExportGenesis outputs the token pairs
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetTokenPairs(ctx))
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+// ConvertCoin converts the native coin of the sender to the ERC20 tokens of the
+// receiver. The coins of a module owned contract are escrowed and the tokens minted,
+// the voucher coins of an external contract are burned and the tokens unescrowed.
+func (k Keeper) ConvertCoin(ctx sdk.Context, coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) error {
+	pair, err := k.GetEnabledTokenPair(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	contract := pair.GetERC20Address()
+	if pair.IsModuleOwned() {
+		_, err := k.CallERC20(ctx, k.ModuleAddress(), contract, true, "mint", receiver, coin.Amount.BigInt())
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.transferERC20(ctx, contract, k.ModuleAddress(), receiver, coin.Amount.BigInt())
+}
+
+// ConvertERC20 converts the ERC20 tokens of the sender to the native coins of the
+// receiver. The tokens of a module owned contract are burned and the coins
+// unescrowed, the tokens of an external contract are escrowed and the voucher
+// coins minted.
+func (k Keeper) ConvertERC20(ctx sdk.Context, contract common.Address, amount sdk.Int, receiver sdk.AccAddress, sender common.Address) error {
+	pair, err := k.GetEnabledTokenPair(ctx, contract.Hex())
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))
+	if pair.IsModuleOwned() {
+		if _, err := k.CallERC20(ctx, k.ModuleAddress(), contract, true, "burnCoins", sender, amount.BigInt()); err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+	}
+
+	if err := k.transferERC20(ctx, contract, sender, k.ModuleAddress(), amount.BigInt()); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+}
+
+// transferERC20 transfers the ERC20 tokens of the external contract, requiring
+// the balance of the recipient to increase by the amount
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	balance, err := k.BalanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.CallERC20(ctx, from, contract, true, "transfer", to, amount)
+	if err != nil {
+		return err
+	}
+	// the contracts predating the ERC20 standard return nothing
+	if len(res.Ret) > 0 {
+		out, err := types.ERC20ABI.Unpack("transfer", res.Ret)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrEVMCall, err.Error())
+		}
+		if ok, _ := out[0].(bool); !ok {
+			return sdkerrors.Wrapf(types.ErrEVMCall, "transfer of %s returned false", contract)
+		}
+	}
+
+	expected := new(big.Int).Add(balance, amount)
+	if balance, err = k.BalanceOf(ctx, contract, to); err != nil {
+		return err
+	}
+	if balance.Cmp(expected) != 0 {
+		return sdkerrors.Wrapf(types.ErrUnexpectedBalance, "expected %s, got %s", expected, balance)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+// GasCap is the gas limit of the EVM calls of the module
+const GasCap uint64 = 25_000_000
+
+// DeployERC20 deploys the ERC20 contract from the module account, which is granted the
+// minter and burner roles of the contract, returning its address
+func (k Keeper) DeployERC20(ctx sdk.Context, name, symbol string, decimals uint8) (common.Address, error) {
+	from := k.ModuleAddress()
+	nonce := k.evmKeeper.GetNonce(ctx, from)
+
+	code, err := types.ERC20Code(name, symbol, decimals)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrap(types.ErrEVMCall, err.Error())
+	}
+	if _, err := k.applyMessage(ctx, from, nil, code, true); err != nil {
+		return common.Address{}, err
+	}
+	return crypto.CreateAddress(from, nonce), nil
+}
+
+// BalanceOf returns the ERC20 balance of the account
+func (k Keeper) BalanceOf(ctx sdk.Context, contract, account common.Address) (*big.Int, error) {
+	res, err := k.CallERC20(ctx, k.ModuleAddress(), contract, false, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := types.ERC20ABI.Unpack("balanceOf", res.Ret)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, err.Error())
+	}
+	return out[0].(*big.Int), nil
+}
+
+// CallERC20 calls the method of the ERC20 contract from the given address. The
+// state changes are committed only if commit is true.
+func (k Keeper) CallERC20(
+	ctx sdk.Context,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := types.ERC20ABI.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, err.Error())
+	}
+	return k.applyMessage(ctx, from, &contract, data, commit)
+}
+
+// applyMessage applies the EVM message without gas price, consuming its gas. The
+// hooks of a committed message are run, as for an ethereum tx.
+func (k Keeper) applyMessage(
+	ctx sdk.Context,
+	from common.Address,
+	to *common.Address,
+	data []byte,
+	commit bool,
+) (res *evmtypes.MsgEthereumTxResponse, err error) {
+	nonce := k.evmKeeper.GetNonce(ctx, from)
+	msg := ethtypes.NewMessage(
+		from, to, nonce, big.NewInt(0), GasCap,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0),
+		data, ethtypes.AccessList{}, !commit,
+	)
+
+	k.executor.Execute(ctx, func(ctx sdk.Context) {
+		res, err = k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), commit)
+		if err != nil || res.Failed() || !commit {
+			return
+		}
+
+		receipt := &ethtypes.Receipt{
+			Type:    ethtypes.LegacyTxType,
+			Status:  ethtypes.ReceiptStatusSuccessful,
+			Logs:    evmtypes.LogsToEthereum(res.Logs),
+			GasUsed: res.GasUsed,
+		}
+		if to == nil {
+			receipt.ContractAddress = crypto.CreateAddress(from, nonce)
+		}
+		err = k.evmKeeper.PostTxProcessing(ctx, from, to, receipt)
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 evm call")
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+				return nil, sdkerrors.Wrapf(types.ErrEVMCall, "%s: %s", res.VmError, reason)
+			}
+		}
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, res.VmError)
+	}
+	return res, nil
+}
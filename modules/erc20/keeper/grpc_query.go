@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// TokenPairs queries the registered token pairs
+func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenPairKey)
+
+	var pairs []types.TokenPair
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenPairsResponse{TokenPairs: pairs, Pagination: pageRes}, nil
+}
+
+// TokenPair queries the token pair of the given denom or ERC20 address
+func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateToken(req.Token); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetTokenPairByToken(ctx, req.Token)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair of %s doesn't exist", req.Token)
+	}
+	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// Keeper defines the erc20 keeper
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           codec.Codec
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	permKeeper    types.PermKeeper
+	tokenKeeper   types.TokenKeeper
+	evmKeeper     types.EVMKeeper
+	executor      types.Executor
+}
+
+// NewKeeper creates a new erc20 Keeper instance. The ERC20 contracts are called
+// through the evm keeper, in the EVM executions run by the executor.
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	permKeeper types.PermKeeper,
+	tokenKeeper types.TokenKeeper,
+	evmKeeper types.EVMKeeper,
+	executor types.Executor,
+) Keeper {
+	// ensure the erc20 module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		permKeeper:    permKeeper,
+		tokenKeeper:   tokenKeeper,
+		evmKeeper:     evmKeeper,
+		executor:      executor,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// ModuleAddress returns the EVM address of the erc20 module account, which owns
+// the ERC20 contracts deployed by the module and escrows the converted tokens
+func (k Keeper) ModuleAddress() common.Address {
+	return common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// IsAdmin returns true if the account is allowed to register and toggle the token pairs
+func (k Keeper) IsAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.permKeeper.IsAuthorized(ctx, address, permtypes.RoleERC20Admin)
+}
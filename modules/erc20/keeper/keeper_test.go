@@ -0,0 +1,350 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+	"github.com/tharsis/ethermint/x/evm/statedb"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/bianjieai/irita/modules/erc20/keeper"
+	"github.com/bianjieai/irita/modules/erc20/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+const (
+	evmDenom   = "uirita"
+	tokenDenom = "ubiz"
+)
+
+var (
+	admin    = testutil.Addr("admin")
+	holder   = testutil.Addr("holder")
+	deployer = common.BytesToAddress(tmhash.SumTruncated([]byte("deployer")))
+)
+
+// tokenKeeper holds the token of the token denom
+type tokenKeeper struct{}
+
+func (tokenKeeper) GetToken(_ sdk.Context, denom string) (tokentypes.TokenI, error) {
+	if denom != tokenDenom {
+		return nil, tokentypes.ErrTokenNotExists
+	}
+	return &tokentypes.Token{Symbol: "biz", Name: "Biz token", MinUnit: tokenDenom, Scale: 6}, nil
+}
+
+// evmKeeper applies the messages on a go-ethereum StateDB
+type evmKeeper struct {
+	db *state.StateDB
+	// postTxs counts the hooks run
+	postTxs int
+}
+
+func (k *evmKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return evmtypes.Params{EvmDenom: evmDenom}
+}
+
+func (k *evmKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 {
+	return k.db.GetNonce(addr)
+}
+
+func (k *evmKeeper) GetAccountWithoutBalance(_ sdk.Context, addr common.Address) *statedb.Account {
+	if !k.db.Exist(addr) {
+		return nil
+	}
+	return &statedb.Account{Nonce: k.db.GetNonce(addr), CodeHash: k.db.GetCodeHash(addr).Bytes()}
+}
+
+func (k *evmKeeper) ApplyMessage(_ sdk.Context, msg core.Message, _ vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
+	evm := vm.NewEVM(vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    msg.Gas(),
+	}, vm.TxContext{Origin: msg.From(), GasPrice: msg.GasPrice()}, k.db, params.TestChainConfig, vm.Config{})
+
+	var (
+		ret   []byte
+		left  uint64
+		vmErr error
+	)
+	snapshot := k.db.Snapshot()
+	if msg.To() == nil {
+		k.db.SetNonce(msg.From(), msg.Nonce())
+		ret, _, left, vmErr = evm.Create(vm.AccountRef(msg.From()), msg.Data(), msg.Gas(), msg.Value())
+		k.db.SetNonce(msg.From(), msg.Nonce()+1)
+	} else {
+		ret, left, vmErr = evm.Call(vm.AccountRef(msg.From()), *msg.To(), msg.Data(), msg.Gas(), msg.Value())
+	}
+	if !commit {
+		k.db.RevertToSnapshot(snapshot)
+	}
+
+	res := &evmtypes.MsgEthereumTxResponse{GasUsed: msg.Gas() - left, Ret: ret}
+	if vmErr != nil {
+		res.VmError = vmErr.Error()
+	}
+	return res, nil
+}
+
+func (k *evmKeeper) PostTxProcessing(sdk.Context, common.Address, *common.Address, *ethtypes.Receipt) error {
+	k.postTxs++
+	return nil
+}
+
+// executor counts the EVM executions
+type executor struct {
+	executions int
+}
+
+func (e *executor) Execute(ctx sdk.Context, fn func(ctx sdk.Context)) {
+	e.executions++
+	fn(ctx)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	env           *testutil.KeeperEnv
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	evmKeeper     *evmKeeper
+	executor      *executor
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), map[string][]string{types.ModuleName: {authtypes.Minter, authtypes.Burner}}, types.StoreKey)
+	suite.env = env
+	suite.ctx = env.Ctx
+	suite.accountKeeper = env.AccountKeeper
+	suite.bankKeeper = env.BankKeeper
+
+	suite.accountKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	env.PermKeeper.SetRole(suite.ctx, admin, permtypes.RoleERC20Admin)
+
+	db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	suite.Require().NoError(err)
+	suite.evmKeeper = &evmKeeper{db: db}
+	suite.executor = &executor{}
+
+	suite.keeper = keeper.NewKeeper(
+		env.Cdc, env.Key(types.StoreKey), suite.accountKeeper, suite.bankKeeper, env.PermKeeper,
+		tokenKeeper{}, suite.evmKeeper, suite.executor,
+	)
+
+	env.Fund(suite.T(), holder, sdk.NewInt64Coin(tokenDenom, 1000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) int64 {
+	balance, err := suite.keeper.BalanceOf(suite.ctx, contract, account)
+	suite.Require().NoError(err)
+	return balance.Int64()
+}
+
+func (suite *KeeperTestSuite) moduleBalance(denom string) int64 {
+	return suite.bankKeeper.GetBalance(suite.ctx, suite.accountKeeper.GetModuleAddress(types.ModuleName), denom).Amount.Int64()
+}
+
+// deployExternal deploys an ERC20 contract minted by the deployer, minting the tokens of the holder
+func (suite *KeeperTestSuite) deployExternal(amount int64) common.Address {
+	evm := vm.NewEVM(vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    keeper.GasCap,
+	}, vm.TxContext{Origin: deployer, GasPrice: big.NewInt(0)}, suite.evmKeeper.db, params.TestChainConfig, vm.Config{})
+
+	code, err := types.ERC20Code("External", "ext", 18)
+	suite.Require().NoError(err)
+	_, contract, _, err := evm.Create(vm.AccountRef(deployer), code, keeper.GasCap, big.NewInt(0))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CallERC20(suite.ctx, deployer, contract, true, "mint", common.BytesToAddress(holder), big.NewInt(amount))
+	suite.Require().NoError(err)
+	return contract
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoin() {
+	_, err := suite.keeper.RegisterCoin(suite.ctx, tokenDenom, holder)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.RegisterCoin(suite.ctx, evmDenom, admin)
+	suite.ErrorIs(err, types.ErrInvalidTokenPair)
+	_, err = suite.keeper.RegisterCoin(suite.ctx, "uother", admin)
+	suite.ErrorIs(err, types.ErrInvalidTokenPair)
+
+	pair, err := suite.keeper.RegisterCoin(suite.ctx, tokenDenom, admin)
+	suite.Require().NoError(err)
+	suite.Equal(types.OwnerModule, pair.ContractOwner)
+	suite.True(pair.Enabled)
+	suite.Equal(1, suite.evmKeeper.postTxs)
+
+	_, err = suite.keeper.RegisterCoin(suite.ctx, tokenDenom, admin)
+	suite.ErrorIs(err, types.ErrTokenPairExists)
+
+	// the contract holds the token metadata and the module mints and burns its tokens
+	contract := pair.GetERC20Address()
+	moduleAddr := suite.keeper.ModuleAddress()
+	for _, call := range []struct {
+		method   string
+		args     []interface{}
+		expected interface{}
+	}{
+		{"name", nil, "Biz token"},
+		{"symbol", nil, "biz"},
+		{"decimals", nil, uint8(6)},
+		{"hasRole", []interface{}{types.MinterRole, moduleAddr}, true},
+		{"hasRole", []interface{}{types.BurnerRole, moduleAddr}, true},
+	} {
+		res, err := suite.keeper.CallERC20(suite.ctx, deployer, contract, false, call.method, call.args...)
+		suite.Require().NoError(err)
+		out, err := types.ERC20ABI.Unpack(call.method, res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal(call.expected, out[0], call.method)
+	}
+
+	found, ok := suite.keeper.GetTokenPairByDenom(suite.ctx, tokenDenom)
+	suite.True(ok)
+	suite.Equal(pair, found)
+	found, ok = suite.keeper.GetTokenPairByToken(suite.ctx, contract.Hex())
+	suite.True(ok)
+	suite.Equal(pair, found)
+	suite.Equal([]types.TokenPair{pair}, suite.keeper.GetTokenPairs(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20() {
+	contract := suite.deployExternal(100)
+
+	_, err := suite.keeper.RegisterERC20(suite.ctx, contract, holder)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.RegisterERC20(suite.ctx, deployer, admin)
+	suite.ErrorIs(err, types.ErrInvalidTokenPair)
+
+	// a contract without balanceOf
+	notERC20 := common.BytesToAddress([]byte("not erc20"))
+	suite.evmKeeper.db.SetCode(notERC20, []byte{byte(vm.STOP)})
+	_, err = suite.keeper.RegisterERC20(suite.ctx, notERC20, admin)
+	suite.ErrorIs(err, types.ErrInvalidTokenPair)
+
+	pair, err := suite.keeper.RegisterERC20(suite.ctx, contract, admin)
+	suite.Require().NoError(err)
+	suite.Equal(types.OwnerExternal, pair.ContractOwner)
+	suite.Equal(types.ERC20Denom(contract), pair.Denom)
+	suite.NoError(pair.Validate())
+
+	_, err = suite.keeper.RegisterERC20(suite.ctx, contract, admin)
+	suite.ErrorIs(err, types.ErrTokenPairExists)
+}
+
+func (suite *KeeperTestSuite) TestToggleConversion() {
+	pair, err := suite.keeper.RegisterCoin(suite.ctx, tokenDenom, admin)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.ToggleConversion(suite.ctx, tokenDenom, holder)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.keeper.ToggleConversion(suite.ctx, "uother", admin)
+	suite.ErrorIs(err, types.ErrUnknownTokenPair)
+
+	toggled, err := suite.keeper.ToggleConversion(suite.ctx, tokenDenom, admin)
+	suite.Require().NoError(err)
+	suite.False(toggled.Enabled)
+
+	err = suite.keeper.ConvertCoin(suite.ctx, sdk.NewInt64Coin(tokenDenom, 1), common.BytesToAddress(holder), holder)
+	suite.ErrorIs(err, types.ErrConversionDisabled)
+
+	toggled, err = suite.keeper.ToggleConversion(suite.ctx, pair.Erc20Address, admin)
+	suite.Require().NoError(err)
+	suite.True(toggled.Enabled)
+	suite.NoError(suite.keeper.ConvertCoin(suite.ctx, sdk.NewInt64Coin(tokenDenom, 1), common.BytesToAddress(holder), holder))
+}
+
+func (suite *KeeperTestSuite) TestConvertModuleOwned() {
+	pair, err := suite.keeper.RegisterCoin(suite.ctx, tokenDenom, admin)
+	suite.Require().NoError(err)
+	contract := pair.GetERC20Address()
+	receiver := common.BytesToAddress([]byte("receiver"))
+	sender := common.BytesToAddress(holder)
+
+	// the coins are escrowed and the tokens minted
+	err = suite.keeper.ConvertCoin(suite.ctx, sdk.NewInt64Coin(tokenDenom, 1001), receiver, holder)
+	suite.Error(err)
+	suite.Require().NoError(suite.keeper.ConvertCoin(suite.ctx, sdk.NewInt64Coin(tokenDenom, 400), sender, holder))
+	suite.Equal(int64(400), suite.balanceOf(contract, sender))
+	suite.Equal(int64(400), suite.moduleBalance(tokenDenom))
+	suite.Equal(int64(600), suite.bankKeeper.GetBalance(suite.ctx, holder, tokenDenom).Amount.Int64())
+
+	// the tokens are burned and the coins unescrowed
+	err = suite.keeper.ConvertERC20(suite.ctx, contract, sdk.NewInt(401), admin, sender)
+	suite.ErrorIs(err, types.ErrEVMCall)
+	suite.Require().NoError(suite.keeper.ConvertERC20(suite.ctx, contract, sdk.NewInt(150), admin, sender))
+	suite.Equal(int64(250), suite.balanceOf(contract, sender))
+	suite.Equal(int64(250), suite.moduleBalance(tokenDenom))
+	suite.Equal(int64(150), suite.bankKeeper.GetBalance(suite.ctx, admin, tokenDenom).Amount.Int64())
+
+	err = suite.keeper.ConvertERC20(suite.ctx, receiver, sdk.NewInt(1), admin, sender)
+	suite.ErrorIs(err, types.ErrUnknownTokenPair)
+}
+
+func (suite *KeeperTestSuite) TestConvertExternal() {
+	contract := suite.deployExternal(100)
+	pair, err := suite.keeper.RegisterERC20(suite.ctx, contract, admin)
+	suite.Require().NoError(err)
+	sender := common.BytesToAddress(holder)
+	module := suite.keeper.ModuleAddress()
+
+	// the tokens are escrowed and the voucher coins minted
+	err = suite.keeper.ConvertERC20(suite.ctx, contract, sdk.NewInt(101), holder, sender)
+	suite.ErrorIs(err, types.ErrEVMCall)
+	suite.Require().NoError(suite.keeper.ConvertERC20(suite.ctx, contract, sdk.NewInt(70), holder, sender))
+	suite.Equal(int64(30), suite.balanceOf(contract, sender))
+	suite.Equal(int64(70), suite.balanceOf(contract, module))
+	suite.Equal(int64(70), suite.bankKeeper.GetBalance(suite.ctx, holder, pair.Denom).Amount.Int64())
+	suite.Equal(int64(0), suite.moduleBalance(pair.Denom))
+
+	// the voucher coins are burned and the tokens unescrowed
+	receiver := common.BytesToAddress([]byte("receiver"))
+	suite.Require().NoError(suite.keeper.ConvertCoin(suite.ctx, sdk.NewInt64Coin(pair.Denom, 20), receiver, holder))
+	suite.Equal(int64(20), suite.balanceOf(contract, receiver))
+	suite.Equal(int64(50), suite.balanceOf(contract, module))
+	suite.Equal(int64(50), suite.bankKeeper.GetBalance(suite.ctx, holder, pair.Denom).Amount.Int64())
+	suite.Equal(int64(50), suite.bankKeeper.GetSupply(suite.ctx, pair.Denom).Amount.Int64())
+
+	// the module cannot convert to itself
+	err = suite.keeper.ConvertCoin(suite.ctx, sdk.NewInt64Coin(pair.Denom, 1), module, holder)
+	suite.ErrorIs(err, types.ErrUnexpectedBalance)
+}
+
+func (suite *KeeperTestSuite) TestCallWithoutCommit() {
+	pair, err := suite.keeper.RegisterCoin(suite.ctx, tokenDenom, admin)
+	suite.Require().NoError(err)
+	postTxs, executions := suite.evmKeeper.postTxs, suite.executor.executions
+	module := suite.keeper.ModuleAddress()
+
+	_, err = suite.keeper.CallERC20(suite.ctx, module, pair.GetERC20Address(), false, "mint", module, big.NewInt(10))
+	suite.Require().NoError(err)
+	suite.Equal(int64(0), suite.balanceOf(pair.GetERC20Address(), module))
+	suite.Equal(postTxs, suite.evmKeeper.postTxs)
+	suite.Equal(executions+2, suite.executor.executions)
+}
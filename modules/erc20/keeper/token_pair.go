@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/erc20/types"
+)
+
+// RegisterCoin deploys the ERC20 contract of the token of the given min unit,
+// owned by the module, and registers the token pair
+func (k Keeper) RegisterCoin(ctx sdk.Context, denom string, operator sdk.AccAddress) (types.TokenPair, error) {
+	if !k.IsAdmin(ctx, operator) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not an erc20 admin", operator)
+	}
+	if k.HasDenom(ctx, denom) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairExists, "denom %s", denom)
+	}
+
+	if denom == k.evmKeeper.GetParams(ctx).EvmDenom {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "%s is the evm denom", denom)
+	}
+	token, err := k.tokenKeeper.GetToken(ctx, denom)
+	if err != nil || token.GetMinUnit() != denom {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "%s is not the min unit of a token", denom)
+	}
+
+	address, err := k.DeployERC20(ctx, token.GetName(), token.GetSymbol(), uint8(token.GetScale()))
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair := types.NewTokenPair(address, denom, types.OwnerModule)
+	if err := pair.Validate(); err != nil {
+		return types.TokenPair{}, err
+	}
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// RegisterERC20 registers the token pair of the external ERC20 contract, whose
+// native coins are the vouchers of the tokens escrowed by the module
+func (k Keeper) RegisterERC20(ctx sdk.Context, address common.Address, operator sdk.AccAddress) (types.TokenPair, error) {
+	if !k.IsAdmin(ctx, operator) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not an erc20 admin", operator)
+	}
+	if _, found := k.GetTokenPair(ctx, address); found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairExists, "ERC20 address %s", address)
+	}
+
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, address)
+	if account == nil || !account.IsContract() {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "%s is not a contract", address)
+	}
+	if _, err := k.BalanceOf(ctx, address, k.ModuleAddress()); err != nil {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "%s is not an ERC20 contract: %s", address, err)
+	}
+
+	pair := types.NewTokenPair(address, types.ERC20Denom(address), types.OwnerExternal)
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// ToggleConversion enables or disables the conversions of the token pair of the
+// given denom or ERC20 address
+func (k Keeper) ToggleConversion(ctx sdk.Context, token string, operator sdk.AccAddress) (types.TokenPair, error) {
+	if !k.IsAdmin(ctx, operator) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not an erc20 admin", operator)
+	}
+	pair, found := k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUnknownTokenPair, "%s", token)
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// GetEnabledTokenPair returns the token pair of the given denom or ERC20 address,
+// if its conversions are enabled
+func (k Keeper) GetEnabledTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUnknownTokenPair, "%s", token)
+	}
+	if !pair.Enabled {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrConversionDisabled, "%s", token)
+	}
+	return pair, nil
+}
+
+// SetTokenPair stores the token pair
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	store := ctx.KVStore(k.storeKey)
+	address := pair.GetERC20Address()
+	store.Set(types.GetTokenPairKey(address), k.cdc.MustMarshal(&pair))
+	store.Set(types.GetDenomKey(pair.Denom), address.Bytes())
+}
+
+// GetTokenPair returns the token pair of the given ERC20 address
+func (k Keeper) GetTokenPair(ctx sdk.Context, address common.Address) (pair types.TokenPair, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenPairKey(address))
+	if bz == nil {
+		return pair, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// GetTokenPairByDenom returns the token pair of the given denom
+func (k Keeper) GetTokenPairByDenom(ctx sdk.Context, denom string) (pair types.TokenPair, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomKey(denom))
+	if bz == nil {
+		return pair, false
+	}
+	return k.GetTokenPair(ctx, common.BytesToAddress(bz))
+}
+
+// GetTokenPairByToken returns the token pair of the given denom or ERC20 address
+func (k Keeper) GetTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, bool) {
+	if common.IsHexAddress(token) {
+		return k.GetTokenPair(ctx, common.HexToAddress(token))
+	}
+	return k.GetTokenPairByDenom(ctx, token)
+}
+
+// HasDenom returns true if the denom belongs to a token pair
+func (k Keeper) HasDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDenomKey(denom))
+}
+
+// GetTokenPairs returns all the token pairs
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenPairKey)
+	defer iterator.Close()
+
+	pairs := []types.TokenPair{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterCoin{}, "irita/erc20/MsgRegisterCoin", nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20{}, "irita/erc20/MsgRegisterERC20", nil)
+	cdc.RegisterConcrete(&MsgToggleConversion{}, "irita/erc20/MsgToggleConversion", nil)
+	cdc.RegisterConcrete(&MsgConvertCoin{}, "irita/erc20/MsgConvertCoin", nil)
+	cdc.RegisterConcrete(&MsgConvertERC20{}, "irita/erc20/MsgConvertERC20", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterCoin{},
+		&MsgRegisterERC20{},
+		&MsgToggleConversion{},
+		&MsgConvertCoin{},
+		&MsgConvertERC20{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
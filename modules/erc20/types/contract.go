@@ -0,0 +1,66 @@
+package types
+
+import (
+	// embed the compiled ERC20 contract
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// erc20JSON is the Hardhat artifact of the ERC20 contract deployed by the module, the
+// ERC20MinterBurnerDecimals contract of Evmos, pinned as released by cosmos/evm v0.3.2
+// along with its source and compiled by solc 0.8.20 against OpenZeppelin Contracts 4.9.6.
+// Based on the ERC20PresetMinterPauser of OpenZeppelin Contracts, it grants its deployer
+// the roles minting and burning the tokens of any holder and pausing the transfers.
+//
+//go:embed contracts/ERC20MinterBurnerDecimals.json
+var erc20JSON []byte
+
+var (
+	// ERC20ABI is the ABI of the ERC20 contract deployed by the module, whose standard methods
+	// are used to call the registered external ERC20 contracts as well
+	ERC20ABI abi.ABI
+
+	// erc20Bin is the creation code of the ERC20 contract deployed by the module
+	erc20Bin []byte
+
+	// MinterRole is the role of the ERC20 contract deployed by the module minting the tokens
+	MinterRole [32]byte
+	// BurnerRole is the role of the ERC20 contract deployed by the module burning the tokens
+	BurnerRole [32]byte
+)
+
+func init() {
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode hexutil.Bytes   `json:"bytecode"`
+	}
+	if err := json.Unmarshal(erc20JSON, &artifact); err != nil {
+		panic(fmt.Errorf("failed to unmarshal the ERC20 artifact: %w", err))
+	}
+	if err := json.Unmarshal(artifact.ABI, &ERC20ABI); err != nil {
+		panic(fmt.Errorf("failed to unmarshal the ERC20 ABI: %w", err))
+	}
+	if len(artifact.Bytecode) == 0 {
+		panic("empty ERC20 bytecode")
+	}
+	erc20Bin = artifact.Bytecode
+
+	copy(MinterRole[:], crypto.Keccak256([]byte("MINTER_ROLE")))
+	copy(BurnerRole[:], crypto.Keccak256([]byte("BURNER_ROLE")))
+}
+
+// ERC20Code returns the creation code of the ERC20 contract deployed by the module for a
+// native token, with the metadata of the token as constructor arguments. The deployer is
+// granted the admin, minter, burner and pauser roles of the contract.
+func ERC20Code(name, symbol string, decimals uint8) ([]byte, error) {
+	args, err := ERC20ABI.Pack("", name, symbol, decimals)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, erc20Bin...), args...), nil
+}
@@ -0,0 +1,241 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	owner  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	holder = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	other  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+type ContractTestSuite struct {
+	suite.Suite
+
+	db       *state.StateDB
+	contract common.Address
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractTestSuite))
+}
+
+func (suite *ContractTestSuite) SetupTest() {
+	db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.db.AddBalance(holder, big.NewInt(1000))
+
+	code, err := ERC20Code("Irita point token", "point", 6)
+	suite.Require().NoError(err)
+	_, suite.contract, _, err = suite.evm(owner).Create(vm.AccountRef(owner), code, 10000000, big.NewInt(0))
+	suite.Require().NoError(err)
+}
+
+func (suite *ContractTestSuite) evm(caller common.Address) *vm.EVM {
+	return vm.NewEVM(vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    10000000,
+	}, vm.TxContext{Origin: caller, GasPrice: big.NewInt(0)}, suite.db, params.TestChainConfig, vm.Config{})
+}
+
+// call calls the method by the caller, returning its outputs
+func (suite *ContractTestSuite) call(caller common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := ERC20ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+	return suite.rawCall(caller, input, big.NewInt(0))
+}
+
+func (suite *ContractTestSuite) rawCall(caller common.Address, input []byte, value *big.Int) ([]interface{}, error) {
+	ret, _, err := suite.evm(caller).Call(vm.AccountRef(caller), suite.contract, input, 1000000, value)
+	if err != nil {
+		return nil, err
+	}
+	method, err := ERC20ABI.MethodById(input[:4])
+	suite.Require().NoError(err)
+	return method.Outputs.Unpack(ret)
+}
+
+func (suite *ContractTestSuite) balanceOf(account common.Address) *big.Int {
+	out, err := suite.call(other, "balanceOf", account)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *ContractTestSuite) totalSupply() *big.Int {
+	out, err := suite.call(other, "totalSupply")
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *ContractTestSuite) allowance(from, spender common.Address) *big.Int {
+	out, err := suite.call(other, "allowance", from, spender)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+// requireLog requires the last log to be the given event
+func (suite *ContractTestSuite) requireLog(event string, from, to common.Address, value int64) {
+	logs := suite.db.Logs()
+	suite.Require().NotEmpty(logs)
+	log := logs[len(logs)-1]
+	suite.Require().Equal(suite.contract, log.Address)
+	suite.Require().Equal([]common.Hash{ERC20ABI.Events[event].ID, from.Hash(), to.Hash()}, log.Topics)
+	suite.Require().Equal(common.BigToHash(big.NewInt(value)).Bytes(), log.Data)
+}
+
+func (suite *ContractTestSuite) TestMetadata() {
+	out, err := suite.call(other, "name")
+	suite.Require().NoError(err)
+	suite.Equal("Irita point token", out[0])
+	out, err = suite.call(other, "symbol")
+	suite.Require().NoError(err)
+	suite.Equal("point", out[0])
+	out, err = suite.call(other, "decimals")
+	suite.Require().NoError(err)
+	suite.Equal(uint8(6), out[0])
+
+	// the deployer is granted the minter and burner roles
+	for _, role := range [][32]byte{MinterRole, BurnerRole} {
+		out, err = suite.call(other, "hasRole", role, owner)
+		suite.Require().NoError(err)
+		suite.Equal(true, out[0])
+		out, err = suite.call(other, "hasRole", role, holder)
+		suite.Require().NoError(err)
+		suite.Equal(false, out[0])
+	}
+	suite.Equal(int64(0), suite.totalSupply().Int64())
+}
+
+func (suite *ContractTestSuite) TestMintBurn() {
+	// only the deployer mints and burns
+	_, err := suite.call(holder, "mint", holder, big.NewInt(100))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	_, err = suite.call(owner, "mint", common.Address{}, big.NewInt(100))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+
+	_, err = suite.call(owner, "mint", holder, big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.requireLog("Transfer", common.Address{}, holder, 100)
+	suite.Equal(int64(100), suite.balanceOf(holder).Int64())
+	suite.Equal(int64(100), suite.totalSupply().Int64())
+
+	// the total supply cannot overflow
+	_, err = suite.call(owner, "mint", other, new(big.Int).Sub(math.MaxBig256, big.NewInt(99)))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+
+	_, err = suite.call(holder, "burnCoins", holder, big.NewInt(40))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	_, err = suite.call(owner, "burnCoins", holder, big.NewInt(101))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+
+	_, err = suite.call(owner, "burnCoins", holder, big.NewInt(40))
+	suite.Require().NoError(err)
+	suite.requireLog("Transfer", holder, common.Address{}, 40)
+	suite.Equal(int64(60), suite.balanceOf(holder).Int64())
+	suite.Equal(int64(60), suite.totalSupply().Int64())
+}
+
+func (suite *ContractTestSuite) TestTransfer() {
+	_, err := suite.call(owner, "mint", holder, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	_, err = suite.call(holder, "transfer", other, big.NewInt(101))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	_, err = suite.call(holder, "transfer", common.Address{}, big.NewInt(1))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+
+	out, err := suite.call(holder, "transfer", other, big.NewInt(30))
+	suite.Require().NoError(err)
+	suite.Equal(true, out[0])
+	suite.requireLog("Transfer", holder, other, 30)
+	suite.Equal(int64(70), suite.balanceOf(holder).Int64())
+	suite.Equal(int64(30), suite.balanceOf(other).Int64())
+
+	// a transfer to self keeps the balance
+	_, err = suite.call(holder, "transfer", holder, big.NewInt(70))
+	suite.Require().NoError(err)
+	suite.Equal(int64(70), suite.balanceOf(holder).Int64())
+	suite.Equal(int64(100), suite.totalSupply().Int64())
+}
+
+func (suite *ContractTestSuite) TestTransferFrom() {
+	_, err := suite.call(owner, "mint", holder, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	_, err = suite.call(holder, "approve", common.Address{}, big.NewInt(50))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	out, err := suite.call(holder, "approve", other, big.NewInt(50))
+	suite.Require().NoError(err)
+	suite.Equal(true, out[0])
+	suite.requireLog("Approval", holder, other, 50)
+	suite.Equal(int64(50), suite.allowance(holder, other).Int64())
+	suite.Equal(int64(0), suite.allowance(other, holder).Int64())
+
+	_, err = suite.call(other, "transferFrom", holder, other, big.NewInt(51))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	_, err = suite.call(owner, "transferFrom", holder, owner, big.NewInt(1))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+
+	out, err = suite.call(other, "transferFrom", holder, owner, big.NewInt(20))
+	suite.Require().NoError(err)
+	suite.Equal(true, out[0])
+	suite.requireLog("Transfer", holder, owner, 20)
+	suite.Equal(int64(30), suite.allowance(holder, other).Int64())
+	suite.Equal(int64(80), suite.balanceOf(holder).Int64())
+	suite.Equal(int64(20), suite.balanceOf(owner).Int64())
+
+	// the infinite allowance is not spent, the balance still limits the transfers
+	_, err = suite.call(holder, "approve", other, math.MaxBig256)
+	suite.Require().NoError(err)
+	_, err = suite.call(other, "transferFrom", holder, other, big.NewInt(80))
+	suite.Require().NoError(err)
+	suite.Equal(math.MaxBig256, suite.allowance(holder, other))
+	_, err = suite.call(other, "transferFrom", holder, other, big.NewInt(1))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+}
+
+func (suite *ContractTestSuite) TestInvalidCalls() {
+	input, err := ERC20ABI.Pack("transfer", other, big.NewInt(0))
+	suite.Require().NoError(err)
+
+	// not payable
+	_, err = suite.rawCall(holder, input, big.NewInt(1))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	// short calldata
+	_, err = suite.rawCall(holder, input[:len(input)-1], big.NewInt(0))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	_, err = suite.rawCall(holder, input[:3], big.NewInt(0))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	// dirty address
+	dirty := append([]byte{}, input...)
+	dirty[4] = 1
+	_, err = suite.rawCall(holder, dirty, big.NewInt(0))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+	// unknown method
+	_, err = suite.rawCall(holder, []byte{1, 2, 3, 4}, big.NewInt(0))
+	suite.ErrorIs(err, vm.ErrExecutionReverted)
+
+	_, err = suite.rawCall(holder, input, big.NewInt(0))
+	suite.NoError(err)
+}
+
+func (suite *ContractTestSuite) TestCodeSize() {
+	code := suite.db.GetCode(suite.contract)
+	suite.NotEmpty(code)
+	suite.Less(len(code), params.MaxCodeSize)
+}
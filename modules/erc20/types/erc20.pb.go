@@ -0,0 +1,505 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: erc20/erc20.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Owner defines the owner of the ERC20 contract of a token pair
+type Owner int32
+
+const (
+	// OWNER_UNSPECIFIED defines an invalid owner
+	OwnerUnspecified Owner = 0
+	// OWNER_MODULE defines an ERC20 contract deployed by the module for a native
+	// token, minted and burned by the module
+	OwnerModule Owner = 1
+	// OWNER_EXTERNAL defines an external ERC20 contract, whose tokens are escrowed
+	// by the module
+	OwnerExternal Owner = 2
+)
+
+var Owner_name = map[int32]string{
+	0: "OWNER_UNSPECIFIED",
+	1: "OWNER_MODULE",
+	2: "OWNER_EXTERNAL",
+}
+
+var Owner_value = map[string]int32{
+	"OWNER_UNSPECIFIED": 0,
+	"OWNER_MODULE":      1,
+	"OWNER_EXTERNAL":    2,
+}
+
+func (x Owner) String() string {
+	return proto.EnumName(Owner_name, int32(x))
+}
+
+func (Owner) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2878374518616987, []int{0}
+}
+
+// TokenPair defines a native token convertible to and from an ERC20 token
+type TokenPair struct {
+	// erc20_address is the hex address of the ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty" yaml:"erc20_address"`
+	// denom is the bank denom of the native token
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// enabled defines whether the token pair can be converted
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// contract_owner is the owner of the ERC20 contract
+	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=irita.erc20.Owner" json:"contract_owner,omitempty" yaml:"contract_owner"`
+}
+
+func (m *TokenPair) Reset()         { *m = TokenPair{} }
+func (m *TokenPair) String() string { return proto.CompactTextString(m) }
+func (*TokenPair) ProtoMessage()    {}
+func (*TokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2878374518616987, []int{0}
+}
+func (m *TokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPair.Merge(m, src)
+}
+func (m *TokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPair proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("irita.erc20.Owner", Owner_name, Owner_value)
+	proto.RegisterType((*TokenPair)(nil), "irita.erc20.TokenPair")
+}
+
+func init() { proto.RegisterFile("erc20/erc20.proto", fileDescriptor_2878374518616987) }
+
+var fileDescriptor_2878374518616987 = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0x87, 0x33, 0x6b, 0x57, 0xdd, 0xd9, 0x6d, 0x4d, 0x87, 0x08, 0x31, 0x87, 0x69, 0x0c, 0x08,
+	0x45, 0x21, 0x91, 0xf5, 0xb6, 0xe0, 0xa1, 0xb5, 0x11, 0x0a, 0xfd, 0x47, 0x6c, 0x51, 0xbc, 0x94,
+	0x49, 0x32, 0xd6, 0xd1, 0x64, 0xa6, 0x4c, 0x52, 0xb4, 0x77, 0x0f, 0xd2, 0x93, 0x5f, 0xa0, 0x20,
+	0xf8, 0x65, 0x7a, 0xec, 0x49, 0x3c, 0x15, 0x6d, 0x2f, 0x9e, 0xfb, 0x09, 0xa4, 0x13, 0x0a, 0xf6,
+	0x32, 0xcc, 0xf3, 0x7b, 0x9f, 0x97, 0x79, 0x87, 0x17, 0x56, 0xa9, 0x8c, 0xae, 0x9f, 0x7a, 0xea,
+	0x74, 0xa7, 0x52, 0xe4, 0x02, 0x5d, 0x32, 0xc9, 0x72, 0xe2, 0xaa, 0xc8, 0x32, 0x26, 0x62, 0x22,
+	0x54, 0xee, 0x1d, 0x6e, 0x85, 0xe2, 0xfc, 0x04, 0xf0, 0x62, 0x28, 0x3e, 0x52, 0x3e, 0x20, 0x4c,
+	0xa2, 0xe7, 0xb0, 0xac, 0xe4, 0x31, 0x89, 0x63, 0x49, 0xb3, 0xcc, 0x04, 0x36, 0xa8, 0x5f, 0x34,
+	0xcd, 0xfd, 0xa6, 0x66, 0xcc, 0x49, 0x9a, 0xdc, 0x38, 0x27, 0x65, 0x27, 0xb8, 0x52, 0xdc, 0x28,
+	0x10, 0x19, 0xf0, 0x3c, 0xa6, 0x5c, 0xa4, 0xe6, 0xd9, 0xa1, 0x2d, 0x28, 0x00, 0x99, 0xf0, 0x0e,
+	0xe5, 0x24, 0x4c, 0x68, 0x6c, 0xde, 0xb2, 0x41, 0xfd, 0x6e, 0x70, 0x44, 0x34, 0x84, 0x95, 0x48,
+	0xf0, 0x5c, 0x92, 0x28, 0x1f, 0x8b, 0x4f, 0x9c, 0x4a, 0xb3, 0x64, 0x83, 0x7a, 0xe5, 0x1a, 0xb9,
+	0xff, 0x0d, 0xee, 0xf6, 0x0f, 0x95, 0xe6, 0x83, 0xfd, 0xa6, 0x76, 0xbf, 0x98, 0xe1, 0xb4, 0xc7,
+	0x09, 0xca, 0xc7, 0x40, 0x99, 0x37, 0xa5, 0xbf, 0xdf, 0x6b, 0xe0, 0xf1, 0x17, 0x00, 0xcf, 0x15,
+	0xa3, 0x27, 0xb0, 0xda, 0x7f, 0xdd, 0xf3, 0x83, 0xf1, 0xa8, 0xf7, 0x6a, 0xe0, 0xbf, 0x68, 0xbf,
+	0x6c, 0xfb, 0x2d, 0x5d, 0xb3, 0x8c, 0xc5, 0xd2, 0xd6, 0x95, 0x31, 0xe2, 0xd9, 0x94, 0x46, 0xec,
+	0x1d, 0xa3, 0x31, 0x7a, 0x08, 0xaf, 0x0a, 0xb9, 0xdb, 0x6f, 0x8d, 0x3a, 0xbe, 0x0e, 0xac, 0x7b,
+	0x8b, 0xa5, 0x7d, 0xa9, 0xbc, 0xae, 0x88, 0x67, 0x09, 0x45, 0x8f, 0x60, 0xa5, 0x50, 0xfc, 0x37,
+	0x43, 0x3f, 0xe8, 0x35, 0x3a, 0xfa, 0x99, 0x55, 0x5d, 0x2c, 0xed, 0xb2, 0x92, 0xfc, 0xcf, 0x39,
+	0x95, 0x9c, 0x24, 0x56, 0xe9, 0xeb, 0x0f, 0xac, 0x35, 0x3b, 0xab, 0x3f, 0x58, 0x5b, 0x6d, 0x31,
+	0x58, 0x6f, 0x31, 0xf8, 0xbd, 0xc5, 0xe0, 0xdb, 0x0e, 0x6b, 0xeb, 0x1d, 0xd6, 0x7e, 0xed, 0xb0,
+	0xf6, 0xd6, 0x9d, 0xb0, 0xfc, 0xfd, 0x2c, 0x74, 0x23, 0x91, 0x7a, 0x21, 0x23, 0xfc, 0x03, 0xa3,
+	0x84, 0x79, 0xea, 0xf3, 0x5e, 0xaa, 0x5e, 0xcb, 0x8a, 0x85, 0x7a, 0xf9, 0x7c, 0x4a, 0xb3, 0xf0,
+	0xb6, 0x5a, 0xda, 0xb3, 0x7f, 0x03, 0x00, 0x8c, 0x35, 0xd8, 0x0d, 0xec, 0x01, 0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipErc20(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthErc20
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupErc20
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthErc20
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthErc20        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowErc20          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupErc20 = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// erc20 module sentinel errors
+var (
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 2, "unauthorized operation")
+	ErrInvalidTokenPair   = sdkerrors.Register(ModuleName, 3, "invalid token pair")
+	ErrTokenPairExists    = sdkerrors.Register(ModuleName, 4, "token pair already registered")
+	ErrUnknownTokenPair   = sdkerrors.Register(ModuleName, 5, "unknown token pair")
+	ErrConversionDisabled = sdkerrors.Register(ModuleName, 6, "token pair conversion disabled")
+	ErrEVMCall            = sdkerrors.Register(ModuleName, 7, "ERC20 contract call failed")
+	ErrUnexpectedBalance  = sdkerrors.Register(ModuleName, 8, "unexpected ERC20 balance")
+)
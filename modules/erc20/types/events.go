@@ -0,0 +1,17 @@
+package types
+
+// erc20 module event types
+const (
+	EventTypeRegisterCoin     = "register_coin"
+	EventTypeRegisterERC20    = "register_erc20"
+	EventTypeToggleConversion = "toggle_token_conversion"
+	EventTypeConvertCoin      = "convert_coin"
+	EventTypeConvertERC20     = "convert_erc20"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyDenom      = "denom"
+	AttributeKeyERC20      = "erc20_address"
+	AttributeKeyEnabled    = "enabled"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyAmount     = "amount"
+)
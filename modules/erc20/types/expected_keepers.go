@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+	"github.com/tharsis/ethermint/x/evm/statedb"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// PermKeeper defines the expected perm keeper
+type PermKeeper interface {
+	IsAuthorized(ctx sdk.Context, address sdk.AccAddress, roles ...permtypes.Role) bool
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error)
+}
+
+// EVMKeeper defines the expected evm keeper
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+	PostTxProcessing(ctx sdk.Context, from common.Address, to *common.Address, receipt *ethtypes.Receipt) error
+}
+
+// Executor runs the EVM executions of the app one at a time
+type Executor interface {
+	Execute(ctx sdk.Context, fn func(ctx sdk.Context))
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewGenesisState constructs a new GenesisState instance
+func NewGenesisState(tokenPairs []TokenPair) *GenesisState {
+	return &GenesisState{
+		TokenPairs: tokenPairs,
+	}
+}
+
+// DefaultGenesisState gets the default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]TokenPair{})
+}
+
+// ValidateGenesis validates the provided erc20 genesis state
+func ValidateGenesis(data GenesisState) error {
+	seenAddresses := make(map[string]bool, len(data.TokenPairs))
+	seenDenoms := make(map[string]bool, len(data.TokenPairs))
+	for _, p := range data.TokenPairs {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		address := strings.ToLower(p.Erc20Address)
+		if seenAddresses[address] {
+			return fmt.Errorf("duplicate token pair of ERC20 address: %s", p.Erc20Address)
+		}
+		if seenDenoms[p.Denom] {
+			return fmt.Errorf("duplicate token pair of denom: %s", p.Denom)
+		}
+		seenAddresses[address] = true
+		seenDenoms[p.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the erc20 module
+	ModuleName = "erc20"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the erc20 module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the erc20 module
+	RouterKey = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	TokenPairKey = []byte{0x01} // prefix for the token pairs
+	DenomKey     = []byte{0x02} // prefix for the ERC20 addresses of the token pair denoms
+)
+
+// GetTokenPairKey gets the key for the token pair of the given ERC20 address
+// VALUE: erc20/TokenPair
+func GetTokenPairKey(address common.Address) []byte {
+	return append(TokenPairKey, address.Bytes()...)
+}
+
+// GetDenomKey gets the key for the ERC20 address of the token pair of the given denom
+// VALUE: []byte{address}
+func GetDenomKey(denom string) []byte {
+	return append(DenomKey, []byte(denom)...)
+}
@@ -0,0 +1,216 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	TypeMsgRegisterCoin     = "register_coin"     // type for MsgRegisterCoin
+	TypeMsgRegisterERC20    = "register_erc20"    // type for MsgRegisterERC20
+	TypeMsgToggleConversion = "toggle_conversion" // type for MsgToggleConversion
+	TypeMsgConvertCoin      = "convert_coin"      // type for MsgConvertCoin
+	TypeMsgConvertERC20     = "convert_erc20"     // type for MsgConvertERC20
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterCoin{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgToggleConversion{}
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgConvertERC20{}
+)
+
+// NewMsgRegisterCoin creates a new MsgRegisterCoin instance.
+func NewMsgRegisterCoin(denom, operator string) *MsgRegisterCoin {
+	return &MsgRegisterCoin{
+		Denom:    denom,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgRegisterCoin) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgRegisterCoin) Type() string { return TypeMsgRegisterCoin }
+
+// ValidateBasic implements Msg.
+func (m MsgRegisterCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTokenPair, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRegisterCoin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRegisterCoin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRegisterERC20 creates a new MsgRegisterERC20 instance.
+func NewMsgRegisterERC20(address, operator string) *MsgRegisterERC20 {
+	return &MsgRegisterERC20{
+		Erc20Address: address,
+		Operator:     operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgRegisterERC20) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgRegisterERC20) Type() string { return TypeMsgRegisterERC20 }
+
+// ValidateBasic implements Msg.
+func (m MsgRegisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if !common.IsHexAddress(m.Erc20Address) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid ERC20 address: %s", m.Erc20Address)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRegisterERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgToggleConversion creates a new MsgToggleConversion instance.
+func NewMsgToggleConversion(token, operator string) *MsgToggleConversion {
+	return &MsgToggleConversion{
+		Token:    token,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgToggleConversion) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgToggleConversion) Type() string { return TypeMsgToggleConversion }
+
+// ValidateBasic implements Msg.
+func (m MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return ValidateToken(m.Token)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgToggleConversion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertCoin creates a new MsgConvertCoin instance.
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgConvertCoin) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// ValidateBasic implements Msg.
+func (m MsgConvertCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if !common.IsHexAddress(m.Receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", m.Receiver)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.Coin)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgConvertCoin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC20 creates a new MsgConvertERC20 instance.
+func NewMsgConvertERC20(address common.Address, amount sdk.Int, receiver sdk.AccAddress, sender common.Address) *MsgConvertERC20 {
+	return &MsgConvertERC20{
+		Erc20Address: address.Hex(),
+		Amount:       amount,
+		Receiver:     receiver.String(),
+		Sender:       sender.Hex(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgConvertERC20) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgConvertERC20) Type() string { return TypeMsgConvertERC20 }
+
+// ValidateBasic implements Msg.
+func (m MsgConvertERC20) ValidateBasic() error {
+	if !common.IsHexAddress(m.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", m.Sender)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if !common.IsHexAddress(m.Erc20Address) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid ERC20 address: %s", m.Erc20Address)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", m.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgConvertERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{common.HexToAddress(m.Sender).Bytes()}
+}
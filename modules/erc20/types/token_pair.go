@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ERC20DenomPrefix is the prefix of the native denoms of the external ERC20 contracts
+const ERC20DenomPrefix = "erc20/"
+
+// ERC20Denom returns the native denom of the external ERC20 contract
+func ERC20Denom(address common.Address) string {
+	return ERC20DenomPrefix + address.Hex()
+}
+
+// NewTokenPair constructs a new enabled TokenPair instance
+func NewTokenPair(address common.Address, denom string, owner Owner) TokenPair {
+	return TokenPair{
+		Erc20Address:  address.Hex(),
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: owner,
+	}
+}
+
+// GetERC20Address returns the address of the ERC20 contract
+func (p TokenPair) GetERC20Address() common.Address {
+	return common.HexToAddress(p.Erc20Address)
+}
+
+// IsModuleOwned returns true if the ERC20 contract is deployed by the module
+func (p TokenPair) IsModuleOwned() bool {
+	return p.ContractOwner == OwnerModule
+}
+
+// Validate validates the token pair
+func (p TokenPair) Validate() error {
+	if !common.IsHexAddress(p.Erc20Address) {
+		return sdkerrors.Wrapf(ErrInvalidTokenPair, "invalid ERC20 address: %s", p.Erc20Address)
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTokenPair, err.Error())
+	}
+
+	switch p.ContractOwner {
+	case OwnerModule:
+		if strings.HasPrefix(p.Denom, ERC20DenomPrefix) {
+			return sdkerrors.Wrapf(ErrInvalidTokenPair, "denom %s is reserved for the external ERC20 contracts", p.Denom)
+		}
+	case OwnerExternal:
+		if p.Denom != ERC20Denom(p.GetERC20Address()) {
+			return sdkerrors.Wrapf(ErrInvalidTokenPair, "denom %s does not match the ERC20 address %s", p.Denom, p.Erc20Address)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidTokenPair, "invalid contract owner: %s", p.ContractOwner)
+	}
+	return nil
+}
+
+// ValidateToken validates the denom or the ERC20 address identifying a token pair
+func ValidateToken(token string) error {
+	if common.IsHexAddress(token) {
+		return nil
+	}
+	if err := sdk.ValidateDenom(token); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTokenPair, fmt.Sprintf("invalid token %s: %s", token, err))
+	}
+	return nil
+}
@@ -1,81 +1,305 @@
 package crypto
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
+	gmsm "github.com/tjfoc/gmsm/sm2"
+	"github.com/tjfoc/gmsm/sm3"
 	"golang.org/x/crypto/sha3"
 )
 
+// Sm2SignatureLength is the length of the signatures of the SM2 signed txs, the SM2 signature
+// followed by the compressed public key of the signer. The SM2 public keys cannot be recovered
+// from the signatures, whose digest depends on the public key, so the txs carry them in V.
+const Sm2SignatureLength = sm2.SignatureSize + sm2.PubKeySize
+
+// ErrInvalidSm2PubKey is returned if V of a tx holds no valid SM2 public key
+var ErrInvalidSm2PubKey = errors.New("invalid SM2 public key")
+
+// HashAlgo is the hash algorithm of the digests of the txs signed with the SM2 keys
+type HashAlgo string
+
+const (
+	// HashKeccak256 hashes the txs as the go-ethereum signers
+	HashKeccak256 HashAlgo = "keccak256"
+	// HashSM3 hashes the txs with SM3, as required by the national cryptography standards
+	HashSM3 HashAlgo = "sm3"
+)
+
+// Validate returns an error if the hash algorithm is unknown
+func (algo HashAlgo) Validate() error {
+	switch algo {
+	case HashKeccak256, HashSM3:
+		return nil
+	default:
+		return fmt.Errorf("unknown hash algorithm %s, expected %s or %s", algo, HashKeccak256, HashSM3)
+	}
+}
+
+// Sm2Signer signs the ethereum txs with the SM2 keys. The txs of all the types are encoded
+// as by the London signer and hashed with the hash algorithm of the signer, and the tx types
+// enabled are those of the signer of the block. The signed txs carry the public key of the
+// signer in V, from which the sender is derived.
 type Sm2Signer struct {
-	types.EIP155Signer
+	// londonSigner derives the sender of the txs signed with ethereum keys, such as those
+	// traced along with the SM2 signed ones
+	londonSigner types.Signer
+	chainId      *big.Int
+	// accessList and dynamicFee enable the EIP-2930 and EIP-1559 txs
+	accessList bool
+	dynamicFee bool
+	hashAlgo   HashAlgo
+}
+
+// NewSm2Signer returns a signer accepting all the tx types
+func NewSm2Signer(chainId *big.Int, hashAlgo HashAlgo) types.Signer {
+	return Sm2Signer{
+		londonSigner: types.NewLondonSigner(chainId),
+		chainId:      chainId,
+		accessList:   true,
+		dynamicFee:   true,
+		hashAlgo:     hashAlgo,
+	}
 }
 
-func NewSm2Signer(chainId *big.Int) types.Signer {
-	return Sm2Signer{types.NewEIP155Signer(chainId)}
+// MakeSm2Signer returns a signer accepting the tx types enabled by the chain config at the
+// given block number, as the go-ethereum MakeSigner
+func MakeSm2Signer(config *params.ChainConfig, blockNumber *big.Int, hashAlgo HashAlgo) Sm2Signer {
+	return Sm2Signer{
+		londonSigner: types.NewLondonSigner(config.ChainID),
+		chainId:      config.ChainID,
+		accessList:   config.IsBerlin(blockNumber),
+		dynamicFee:   config.IsLondon(blockNumber),
+		hashAlgo:     hashAlgo,
+	}
 }
 
+// Sender returns the address of the SM2 public key carried by the tx, once the signature
+// is verified against it. The sender of the txs signed with ethereum keys is recovered by
+// the London signer.
 func (s Sm2Signer) Sender(tx *types.Transaction) (common.Address, error) {
-	return s.EIP155Signer.Sender(tx)
+	if !IsSm2Tx(tx) {
+		return s.londonSigner.Sender(tx)
+	}
+	pubKey, err := s.SenderPubKey(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(pubKey.Address()), nil
+}
+
+// SenderPubKey returns the SM2 public key carried by the tx, once the signature is verified
+// against it
+func (s Sm2Signer) SenderPubKey(tx *types.Transaction) (*sm2.PubKey, error) {
+	if err := s.ValidateTx(tx); err != nil {
+		return nil, err
+	}
+	pubKey, err := Sm2PubKey(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, R, S := tx.RawSignatureValues()
+	if R.BitLen() > 256 || S.BitLen() > 256 {
+		return nil, types.ErrInvalidSig
+	}
+	sig := make([]byte, sm2.SignatureSize)
+	R.FillBytes(sig[:32])
+	S.FillBytes(sig[32:])
+	if !pubKey.VerifySignature(s.Hash(tx).Bytes(), sig) {
+		return nil, types.ErrInvalidSig
+	}
+	return pubKey, nil
 }
 
 func (s Sm2Signer) ChainID() *big.Int {
-	return s.EIP155Signer.ChainID()
+	return s.chainId
+}
+
+// HashAlgo returns the hash algorithm of the signed digests
+func (s Sm2Signer) HashAlgo() HashAlgo {
+	return s.hashAlgo
 }
 
-// hasherPool holds LegacyKeccak256 hashers for rlpHash.
+// hasherPool holds LegacyKeccak256 hashers for keccakRlpHash.
 var hasherPool = sync.Pool{
 	New: func() interface{} { return sha3.NewLegacyKeccak256() },
 }
 
+// Hash returns the hash to be signed, the EIP-155 hash of the legacy txs and the hash of
+// the typed tx envelope of the others, hashed with the hash algorithm of the signer
 func (s Sm2Signer) Hash(tx *types.Transaction) common.Hash {
-	return rlpHash([]interface{}{
-		tx.Nonce(),
-		tx.GasPrice(),
-		tx.Gas(),
-		tx.To(),
-		tx.Value(),
-		tx.Data(),
-		s.ChainID(), uint(0), uint(0),
-	})
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return s.rlpHash(nil, []interface{}{
+			tx.Nonce(),
+			tx.GasPrice(),
+			tx.Gas(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			s.ChainID(), uint(0), uint(0),
+		})
+	case types.AccessListTxType:
+		return s.rlpHash([]byte{tx.Type()}, []interface{}{
+			s.ChainID(),
+			tx.Nonce(),
+			tx.GasPrice(),
+			tx.Gas(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			tx.AccessList(),
+		})
+	case types.DynamicFeeTxType:
+		return s.rlpHash([]byte{tx.Type()}, []interface{}{
+			s.ChainID(),
+			tx.Nonce(),
+			tx.GasTipCap(),
+			tx.GasFeeCap(),
+			tx.Gas(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			tx.AccessList(),
+		})
+	default:
+		// the signature of unknown tx types cannot be verified
+		return common.Hash{}
+	}
 }
 
 func (s Sm2Signer) Equal(signer types.Signer) bool {
-	return s.EIP155Signer.Equal(signer)
+	other, ok := signer.(Sm2Signer)
+	return ok && other.chainId.Cmp(s.chainId) == 0 &&
+		other.accessList == s.accessList && other.dynamicFee == s.dynamicFee && other.hashAlgo == s.hashAlgo
 }
 
 var _ types.Signer = &Sm2Signer{}
 
+// decodeSignature returns the values of the signature of a SM2 signed tx, V being the public key
 func decodeSignature(sig []byte) (r, s, v *big.Int) {
-	//if len(sig) != crypto.SignatureLength {
-	//	panic(fmt.Sprintf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength))
-	//}
+	if len(sig) != Sm2SignatureLength {
+		panic(fmt.Sprintf("wrong size for signature: got %d, want %d", len(sig), Sm2SignatureLength))
+	}
 	r = new(big.Int).SetBytes(sig[:32])
-	s = new(big.Int).SetBytes(sig[32:64])
-	//v = new(big.Int).SetBytes([]byte{sig[64] + 27})
+	s = new(big.Int).SetBytes(sig[32:sm2.SignatureSize])
+	v = new(big.Int).SetBytes(sig[sm2.SignatureSize:])
 	return r, s, v
 }
 
+// Sm2PubKey returns the SM2 public key carried in V by the tx, without verifying the signature
+func Sm2PubKey(tx *types.Transaction) (*sm2.PubKey, error) {
+	v, _, _ := tx.RawSignatureValues()
+	if v == nil || v.BitLen() > 8*sm2.PubKeySize {
+		return nil, ErrInvalidSm2PubKey
+	}
+	key := v.FillBytes(make([]byte, sm2.PubKeySize))
+	if !isOnCurve(key) {
+		return nil, ErrInvalidSm2PubKey
+	}
+	return &sm2.PubKey{Key: key}, nil
+}
+
+// IsSm2Tx returns true if the tx is signed with a SM2 key, V holding a SM2 public key rather
+// than the recovery id of a secp256k1 signature
+func IsSm2Tx(tx *types.Transaction) bool {
+	_, err := Sm2PubKey(tx)
+	return err == nil
+}
+
+// isOnCurve returns true if the compressed public key is a point of the SM2 curve,
+// y^2 = x^3 - 3x + b having a solution for x
+func isOnCurve(key []byte) bool {
+	if key[0] != 2 && key[0] != 3 {
+		return false
+	}
+	params := gmsm.P256Sm2().Params()
+	x := new(big.Int).SetBytes(key[1:])
+	if x.Cmp(params.P) >= 0 {
+		return false
+	}
+
+	y2 := new(big.Int).Exp(x, big.NewInt(3), params.P)
+	y2.Sub(y2, new(big.Int).Mul(x, big.NewInt(3)))
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+	return big.Jacobi(y2, params.P) >= 0
+}
+
+// ValidateTx checks that the tx type is enabled and that the typed txs are bound to the
+// chain ID of the signer, as the London signer
+func (s Sm2Signer) ValidateTx(tx *types.Transaction) error {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		// the legacy txs are bound to the chain ID by their hash
+		return nil
+	case types.AccessListTxType:
+		if !s.accessList {
+			return types.ErrTxTypeNotSupported
+		}
+	case types.DynamicFeeTxType:
+		if !s.dynamicFee {
+			return types.ErrTxTypeNotSupported
+		}
+	default:
+		return types.ErrTxTypeNotSupported
+	}
+
+	if tx.ChainId().Cmp(s.ChainID()) != 0 {
+		return types.ErrInvalidChainId
+	}
+	return nil
+}
+
 func (s Sm2Signer) SignatureValues(tx *types.Transaction, sig []byte) (R, S, V *big.Int, err error) {
-	// because it indicates that the chain ID was not specified in the tx.
-	if tx.ChainId().Sign() != 0 && tx.ChainId().Cmp(s.ChainID()) != 0 {
-		return nil, nil, nil, types.ErrInvalidChainId
+	if len(sig) != Sm2SignatureLength {
+		return nil, nil, nil, fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), Sm2SignatureLength)
 	}
-	R, S, _ = decodeSignature(sig)
-	//V = big.NewInt(int64(sig[64]))
+	if err := s.ValidateTx(tx); err != nil {
+		return nil, nil, nil, err
+	}
+	if !isOnCurve(sig[sm2.SignatureSize:]) {
+		return nil, nil, nil, ErrInvalidSm2PubKey
+	}
+	R, S, V = decodeSignature(sig)
 	return R, S, V, nil
 }
 
-// rlpHash encodes x and hashes the encoded bytes.
-func rlpHash(x interface{}) (h common.Hash) {
+// rlpHash writes the prefix of the typed txs before rlp-encoding x, and hashes the encoded
+// bytes with the hash algorithm of the signer
+func (s Sm2Signer) rlpHash(prefix []byte, x interface{}) common.Hash {
+	if s.hashAlgo == HashSM3 {
+		return sm3RlpHash(prefix, x)
+	}
+	return keccakRlpHash(prefix, x)
+}
+
+// keccakRlpHash writes the prefix into the hasher before rlp-encoding x.
+func keccakRlpHash(prefix []byte, x interface{}) (h common.Hash) {
 	sha := hasherPool.Get().(crypto.KeccakState)
 	defer hasherPool.Put(sha)
 	sha.Reset()
+	sha.Write(prefix)
 	rlp.Encode(sha, x)
 	sha.Read(h[:])
 	return h
 }
+
+// sm3RlpHash writes the prefix into the SM3 hasher before rlp-encoding x.
+func sm3RlpHash(prefix []byte, x interface{}) (h common.Hash) {
+	sha := sm3.New()
+	sha.Write(prefix)
+	rlp.Encode(sha, x)
+	copy(h[:], sha.Sum(nil))
+	return h
+}
@@ -0,0 +1,207 @@
+package crypto_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/bianjieai/irita/modules/evm/crypto"
+)
+
+var (
+	chainID  = big.NewInt(1223)
+	to       = common.HexToAddress("0x095e7baea6a6c7c4c2dfeb977efac326af552d87")
+	data     = common.FromHex("0x60806040")
+	accesses = ethtypes.AccessList{{
+		Address:     common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		StorageKeys: []common.Hash{common.HexToHash("0x01")},
+	}}
+)
+
+// txVectors are the txs of each type with their Keccak-256 and SM3 hashes to be signed
+var txVectors = []struct {
+	name    string
+	tx      *ethtypes.Transaction
+	hash    string
+	sm3Hash string
+}{
+	{
+		"legacy",
+		ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(100), Data: data,
+		}),
+		"0xf85bdcf9fd76aefa44b4ed3fbcf1438ec614fd70242f59b1ab1a07d173c35956",
+		"0xdf374a8ce40a332b0e4f653ae2318c8c5696c11a39122e39cbdf43964dafd309",
+	},
+	{
+		"access list",
+		ethtypes.NewTx(&ethtypes.AccessListTx{
+			ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(10), Gas: 30000, To: &to, Value: big.NewInt(100),
+			Data: data, AccessList: accesses,
+		}),
+		"0x6dc38411dba97d83ee58779ac1ec020b56b8a5b471750486541b20e45fb35bda",
+		"0x4a1975803510ebf08d878515e5c2ebbab33c57700a06831883eb6dfead125422",
+	},
+	{
+		"dynamic fee",
+		ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(20), Gas: 30000, To: &to,
+			Value: big.NewInt(100), Data: data, AccessList: accesses,
+		}),
+		"0x6f6151228492907103a069843f68300637a1730458a889ac7c4668850371ad93",
+		"0x0134774ee28cfb15c3a72b2dbeea69fb476c8117982f644b6158c32a24bb35d8",
+	},
+	{
+		"dynamic fee contract creation",
+		ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID: chainID, Nonce: 4, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(20), Gas: 100000, Data: data,
+		}),
+		"0x2fcd649ecaecc107e2198017fb471e131b3afeff10d5039e17b25bc36137dde7",
+		"0x4a9819f98231ebc29e29deda306d51176297cd188cf5f6ead709907287ba91a4",
+	},
+}
+
+func TestSm2SignerHash(t *testing.T) {
+	signer := crypto.NewSm2Signer(chainID, crypto.HashKeccak256)
+	londonSigner := ethtypes.NewLondonSigner(chainID)
+
+	for _, v := range txVectors {
+		hash := signer.Hash(v.tx)
+		require.Equal(t, v.hash, hash.Hex(), v.name)
+		// the txs are hashed as by the London signer
+		require.Equal(t, londonSigner.Hash(v.tx), hash, v.name)
+	}
+}
+
+func TestSm2SignerSm3Hash(t *testing.T) {
+	privKey := sm2.GenPrivKeyFromSecret([]byte("sm2 signer"))
+	signer := crypto.NewSm2Signer(chainID, crypto.HashSM3)
+	keccakSigner := crypto.NewSm2Signer(chainID, crypto.HashKeccak256)
+	require.False(t, signer.Equal(keccakSigner))
+
+	for _, v := range txVectors {
+		require.Equal(t, v.sm3Hash, signer.Hash(v.tx).Hex(), v.name)
+
+		sig, err := privKey.Sign(signer.Hash(v.tx).Bytes())
+		require.NoError(t, err, v.name)
+		signed, err := v.tx.WithSignature(signer, append(sig, privKey.PubKey().Bytes()...))
+		require.NoError(t, err, v.name)
+
+		from, err := signer.Sender(signed)
+		require.NoError(t, err, v.name)
+		require.Equal(t, common.BytesToAddress(privKey.PubKey().Address()), from, v.name)
+		// the signature of the SM3 digest does not verify against the Keccak-256 one
+		_, err = keccakSigner.Sender(signed)
+		require.ErrorIs(t, err, ethtypes.ErrInvalidSig, v.name)
+	}
+
+	require.NoError(t, crypto.HashSM3.Validate())
+	require.Error(t, crypto.HashAlgo("sha256").Validate())
+}
+
+func TestSm2SignerSign(t *testing.T) {
+	privKey := sm2.GenPrivKeyFromSecret([]byte("sm2 signer"))
+	pubKey := privKey.PubKey()
+	sender := common.BytesToAddress(pubKey.Address())
+	signer := crypto.NewSm2Signer(chainID, crypto.HashKeccak256)
+
+	for _, v := range txVectors {
+		sig, err := privKey.Sign(signer.Hash(v.tx).Bytes())
+		require.NoError(t, err, v.name)
+		signed, err := v.tx.WithSignature(signer, append(sig, pubKey.Bytes()...))
+		require.NoError(t, err, v.name)
+		require.Equal(t, v.tx.Type(), signed.Type(), v.name)
+		require.True(t, crypto.IsSm2Tx(signed), v.name)
+
+		// the sender is derived from the tx carried by the cosmos msg
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(signed), v.name)
+		ethTx := msg.AsTransaction()
+		require.Equal(t, signer.Hash(v.tx), signer.Hash(ethTx), v.name)
+
+		from, err := ethtypes.Sender(signer, ethTx)
+		require.NoError(t, err, v.name)
+		require.Equal(t, sender, from, v.name)
+		senderPubKey, err := signer.(crypto.Sm2Signer).SenderPubKey(ethTx)
+		require.NoError(t, err, v.name)
+		require.True(t, pubKey.Equals(senderPubKey), v.name)
+
+		// the signature of another key or tx is rejected
+		otherKey := sm2.GenPrivKeyFromSecret([]byte("other")).PubKey()
+		forged, err := v.tx.WithSignature(signer, append(sig, otherKey.Bytes()...))
+		require.NoError(t, err, v.name)
+		_, err = signer.Sender(forged)
+		require.ErrorIs(t, err, ethtypes.ErrInvalidSig, v.name)
+		_, err = signer.(crypto.Sm2Signer).SenderPubKey(v.tx)
+		require.ErrorIs(t, err, crypto.ErrInvalidSm2PubKey, v.name)
+	}
+
+	sig := make([]byte, crypto.Sm2SignatureLength)
+	_, err := txVectors[0].tx.WithSignature(signer, sig[:sm2.SignatureSize+1])
+	require.Error(t, err)
+	// V must hold a compressed point of the curve
+	sig[sm2.SignatureSize] = 4
+	_, err = txVectors[0].tx.WithSignature(signer, sig)
+	require.ErrorIs(t, err, crypto.ErrInvalidSm2PubKey)
+}
+
+func TestIsSm2Tx(t *testing.T) {
+	require.False(t, crypto.IsSm2Tx(txVectors[0].tx))
+
+	// the txs signed with ethereum keys carry a recovery id in V
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	for _, v := range txVectors {
+		signed, err := ethtypes.SignTx(v.tx, ethtypes.NewLondonSigner(chainID), key)
+		require.NoError(t, err, v.name)
+		require.False(t, crypto.IsSm2Tx(signed), v.name)
+
+		// whose sender is still derived by the SM2 signer
+		from, err := ethtypes.Sender(crypto.NewSm2Signer(chainID, crypto.HashKeccak256), signed)
+		require.NoError(t, err, v.name)
+		require.Equal(t, ethcrypto.PubkeyToAddress(key.PublicKey), from, v.name)
+	}
+}
+
+func TestSm2SignerValidateTx(t *testing.T) {
+	config := *params.AllEthashProtocolChanges
+	config.ChainID = chainID
+	config.LondonBlock = big.NewInt(10)
+	config.BerlinBlock = big.NewInt(5)
+
+	for height, enabled := range map[int64][]bool{
+		1:  {true, false, false, false},
+		5:  {true, true, false, false},
+		10: {true, true, true, true},
+	} {
+		signer := crypto.MakeSm2Signer(&config, big.NewInt(height), crypto.HashKeccak256)
+		for i, v := range txVectors {
+			err := signer.ValidateTx(v.tx)
+			if enabled[i] {
+				require.NoError(t, err, "%s at %d", v.name, height)
+			} else {
+				require.ErrorIs(t, err, ethtypes.ErrTxTypeNotSupported, "%s at %d", v.name, height)
+			}
+		}
+	}
+
+	// the typed txs of other chains are rejected
+	signer := crypto.NewSm2Signer(big.NewInt(1), crypto.HashKeccak256)
+	require.NoError(t, signer.(crypto.Sm2Signer).ValidateTx(txVectors[0].tx))
+	for _, v := range txVectors[1:] {
+		require.ErrorIs(t, signer.(crypto.Sm2Signer).ValidateTx(v.tx), ethtypes.ErrInvalidChainId, v.name)
+		_, err := v.tx.WithSignature(signer, make([]byte, crypto.Sm2SignatureLength))
+		require.ErrorIs(t, err, ethtypes.ErrInvalidChainId, v.name)
+	}
+
+	require.True(t, crypto.NewSm2Signer(chainID, crypto.HashKeccak256).Equal(crypto.MakeSm2Signer(&config, big.NewInt(10), crypto.HashKeccak256)))
+	require.False(t, crypto.NewSm2Signer(chainID, crypto.HashKeccak256).Equal(crypto.MakeSm2Signer(&config, big.NewInt(1), crypto.HashKeccak256)))
+	require.False(t, crypto.NewSm2Signer(chainID, crypto.HashKeccak256).Equal(ethtypes.NewLondonSigner(chainID)))
+}
@@ -5,12 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
-	ethermint "github.com/tharsis/ethermint/types"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	evmcrypto "github.com/bianjieai/irita/modules/evm/crypto"
@@ -18,16 +15,18 @@ import (
 
 // EthSigVerificationDecorator validates an ethereum signatures
 type EthSigVerificationDecorator struct {
-	accountKeeper   authante.AccountKeeper
+	accountKeeper   AccountKeeper
 	signModeHandler authsigning.SignModeHandler
 	evmKeeper       EVMKeeper
+	sm2Keeper       Sm2Keeper
 }
 
 // NewEthSigVerificationDecorator creates a new EthSigVerificationDecorator
-func NewEthSigVerificationDecorator(ek EVMKeeper, ak authante.AccountKeeper, signModeHandler authsigning.SignModeHandler) EthSigVerificationDecorator {
+func NewEthSigVerificationDecorator(ek EVMKeeper, ak AccountKeeper, sk Sm2Keeper, signModeHandler authsigning.SignModeHandler) EthSigVerificationDecorator {
 	return EthSigVerificationDecorator{
 		evmKeeper:       ek,
 		accountKeeper:   ak,
+		sm2Keeper:       sk,
 		signModeHandler: signModeHandler,
 	}
 }
@@ -51,60 +50,60 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 		if !ok {
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
 		}
-		if msgEthTx.From == "" {
-			if err := esvd.anteHandle(msgEthTx, signer); err != nil {
+		// the SM2 signed txs carry the pubkey of the signer in V
+		if evmcrypto.IsSm2Tx(msgEthTx.AsTransaction()) {
+			if err := esvd.anteHandleSm2(ctx, msgEthTx, evmcrypto.MakeSm2Signer(ethCfg, blockNum, esvd.sm2Keeper.EvmTxHashAlgo(ctx)), simulate); err != nil {
 				return ctx, err
 			}
-		} else {
-			ethAddr := common.HexToAddress(msgEthTx.From)
-			cosmosAddr := sdk.AccAddress(ethAddr.Bytes())
-			account := esvd.accountKeeper.GetAccount(ctx, cosmosAddr)
-
-			pubKey := account.GetPubKey()
-			if pubKey != nil && pubKey.Type() == ethsecp256k1.KeyType {
-				if err := esvd.anteHandle(msgEthTx, signer); err != nil {
-					return ctx, err
-				}
-			} else {
-				if err := esvd.anteHandleSm2(ctx, msgEthTx, tx, simulate); err != nil {
-					return ctx, err
-				}
-			}
+		} else if err := esvd.anteHandle(msgEthTx, signer); err != nil {
+			return ctx, err
 		}
-
 	}
 	return next(ctx, tx, simulate)
 }
 
-func (esvd EthSigVerificationDecorator) anteHandleSm2(ctx sdk.Context, msgEthTx *evmtypes.MsgEthereumTx, tx sdk.Tx, simulate bool) error {
-
-	sigTx, ok := tx.(authsigning.SigVerifiableTx)
-	if !ok {
-		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
-	}
+// anteHandleSm2 sets the sender of the SM2 signed tx to the address of the pubkey it carries,
+// once the signature is verified against it. The pubkey is set on the account of the sender
+// if not already, so that new accounts need no pubkey on-chain before their first tx.
+func (esvd EthSigVerificationDecorator) anteHandleSm2(ctx sdk.Context, msgEthTx *evmtypes.MsgEthereumTx, signer evmcrypto.Sm2Signer, simulate bool) error {
 	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
 	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid unpack transaction data")
 	}
-	_, r, s := txData.GetRawSignatureValues()
-	R := r.Bytes()
-	S := s.Bytes()
-	sig := make([]byte, 64)
-	copy(sig[32-len(R):32], R[:])
-	copy(sig[64-len(S):64], S[:])
-
-	signerAddrs := sigTx.GetSigners()
-	acc, err := authante.GetSignerAcc(ctx, esvd.accountKeeper, signerAddrs[0])
+
+	ethTx := msgEthTx.AsTransaction()
+	// as the London signer, reject the tx types not enabled and the typed txs of other chains
+	if err := signer.ValidateTx(ethTx); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "invalid %d type transaction: %s", ethTx.Type(), err)
+	}
+
+	pubKey, err := evmcrypto.Sm2PubKey(ethTx)
+	if !simulate {
+		pubKey, err = signer.SenderPubKey(ethTx)
+	}
 	if err != nil {
-		return err
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unable to verify the SM2 signature: %s", err)
+	}
+
+	sender := common.BytesToAddress(pubKey.Address())
+	if msgEthTx.From != "" && common.HexToAddress(msgEthTx.From) != sender {
+		return sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "the sender %s is not the signer %s", msgEthTx.From, sender)
 	}
-	//todo
+	msgEthTx.From = sender.Hex()
 
-	msgEthTx.From = common.BytesToAddress(acc.GetAddress()).String()
-	// retrieve pubkey
-	pubKey := acc.GetPubKey()
-	if !simulate && pubKey == nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+	addr := sdk.AccAddress(sender.Bytes())
+	acc := esvd.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = esvd.accountKeeper.NewAccountWithAddress(ctx, addr)
+	}
+	switch accPubKey := acc.GetPubKey(); {
+	case accPubKey == nil:
+		if err := acc.SetPubKey(pubKey); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+		esvd.accountKeeper.SetAccount(ctx, acc)
+	case !accPubKey.Equals(pubKey):
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "the pubkey of the account %s is not the signer pubkey", addr)
 	}
 
 	// Check account sequence number.
@@ -115,20 +114,6 @@ func (esvd EthSigVerificationDecorator) anteHandleSm2(ctx sdk.Context, msgEthTx
 		)
 	}
 
-	chainID, err := ethermint.ParseChainID(ctx.ChainID())
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidChainID, "chainID is invalid %s", chainID)
-	}
-
-	signer := evmcrypto.NewSm2Signer(chainID)
-	ethTx := msgEthTx.AsTransaction()
-	txHash := signer.Hash(ethTx)
-	if !simulate {
-		if !pubKey.VerifySignature(txHash.Bytes(), sig) {
-			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unable to verify single signer signature")
-		}
-	}
-
 	return nil
 }
 
@@ -149,6 +134,96 @@ func (esvd EthSigVerificationDecorator) anteHandle(msgEthTx *evmtypes.MsgEthereu
 	return nil
 }
 
+// ContractCallable defines the expected interface to check whether a contract is blocked
 type ContractCallable interface {
 	GetBlockContract(sdk.Context, []byte) bool
 }
+
+// EthContractCallableDecorator rejects the ethereum txs which call a blocked contract.
+//
+// Only the top-level calls, the recipients of the txs, are checked. The calls made by a contract to a blocked
+// one through the CALL, DELEGATECALL and STATICCALL opcodes are not, the EVM having no hook to reject them:
+// blocking a contract does not prevent the other contracts from calling it.
+type EthContractCallableDecorator struct {
+	contractCallable ContractCallable
+}
+
+// NewEthContractCallableDecorator creates a new EthContractCallableDecorator
+func NewEthContractCallableDecorator(cc ContractCallable) EthContractCallableDecorator {
+	return EthContractCallableDecorator{
+		contractCallable: cc,
+	}
+}
+
+// AnteHandle checks that the recipient of each ethereum tx is not a blocked contract.
+// Contract creations are always allowed.
+func (eccd EthContractCallableDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid unpack transaction data")
+		}
+
+		to := txData.GetTo()
+		if to != nil && eccd.contractCallable.GetBlockContract(ctx, to.Bytes()) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "the contract %s is blocked", to.Hex())
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ContractDeployable defines the expected interface to check whether an account is allowed to deploy contracts
+type ContractDeployable interface {
+	CanDeployContract(sdk.Context, []byte) bool
+}
+
+// EthContractDeployerDecorator rejects the contract creations sent by the accounts out of the deployer allowlist.
+// It must run after EthSigVerificationDecorator, which sets the sender of both the ethereum and the SM2 signed txs.
+//
+// Only the top-level creations, the txs without recipient, are checked. The contracts created by the CREATE and
+// CREATE2 opcodes are not, the EVM having no hook to reject them: any account may deploy contracts through a
+// factory contract deployed by an allowed deployer, who is thus responsible for the contracts it deploys.
+type EthContractDeployerDecorator struct {
+	contractDeployable ContractDeployable
+}
+
+// NewEthContractDeployerDecorator creates a new EthContractDeployerDecorator
+func NewEthContractDeployerDecorator(cd ContractDeployable) EthContractDeployerDecorator {
+	return EthContractDeployerDecorator{
+		contractDeployable: cd,
+	}
+}
+
+// AnteHandle checks that the sender of each contract creation is an allowed deployer
+func (ecdd EthContractDeployerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid unpack transaction data")
+		}
+
+		if txData.GetTo() != nil {
+			continue
+		}
+
+		from := common.HexToAddress(msgEthTx.From)
+		if !ecdd.contractDeployable.CanDeployContract(ctx, from.Bytes()) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"contract deployment not allowed: %s (%s) is not in the contract deployer allowlist",
+				from.Hex(), sdk.AccAddress(from.Bytes()),
+			)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -12,6 +14,8 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/tharsis/ethermint/x/evm/statedb"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	evmcrypto "github.com/bianjieai/irita/modules/evm/crypto"
 )
 
 // EVMKeeper defines the expected keeper interface used on the Eth AnteHandler
@@ -29,6 +33,18 @@ type EVMKeeper interface {
 	ResetTransientGasUsed(ctx sdk.Context)
 }
 
+// AccountKeeper defines the expected account keeper used on the Eth AnteHandler
+type AccountKeeper interface {
+	authante.AccountKeeper
+
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// Sm2Keeper defines the expected keeper providing the hash algorithm of the SM2 signed txs
+type Sm2Keeper interface {
+	EvmTxHashAlgo(ctx sdk.Context) evmcrypto.HashAlgo
+}
+
 type protoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
@@ -38,3 +54,9 @@ type FeegrantKeeper interface {
 	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// Executor defines the expected executor of the EVM executions, providing their context to the
+// precompiled contracts
+type Executor interface {
+	Execute(ctx sdk.Context, fn func(ctx sdk.Context))
+}
@@ -0,0 +1,90 @@
+package evm
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	ethermintevm "github.com/tharsis/ethermint/x/evm"
+	evmkeeper "github.com/tharsis/ethermint/x/evm/keeper"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the evm module.
+// It extends the ethermint evm module, running the EVM executions of its services through the
+// executor.
+type AppModule struct {
+	ethermintevm.AppModule
+
+	keeper   *evmkeeper.Keeper
+	executor Executor
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(k *evmkeeper.Keeper, ak evmtypes.AccountKeeper, executor Executor) AppModule {
+	return AppModule{
+		AppModule: ethermintevm.NewAppModule(k, ak),
+		keeper:    k,
+		executor:  executor,
+	}
+}
+
+// RegisterServices registers the msg and query services of the evm module
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	evmtypes.RegisterMsgServer(cfg.MsgServer(), msgServer{keeper: am.keeper, executor: am.executor})
+	evmtypes.RegisterQueryServer(cfg.QueryServer(), queryServer{QueryServer: am.keeper, executor: am.executor})
+}
+
+// Route returns the message routing key for the evm module.
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(evmtypes.RouterKey, ethermintevm.NewHandler(msgServer{keeper: am.keeper, executor: am.executor}))
+}
+
+// msgServer runs the Ethereum txs through the executor
+type msgServer struct {
+	keeper   evmtypes.MsgServer
+	executor Executor
+}
+
+func (s msgServer) EthereumTx(goCtx context.Context, msg *evmtypes.MsgEthereumTx) (res *evmtypes.MsgEthereumTxResponse, err error) {
+	s.executor.Execute(sdk.UnwrapSDKContext(goCtx), func(ctx sdk.Context) {
+		res, err = s.keeper.EthereumTx(sdk.WrapSDKContext(ctx), msg)
+	})
+	return res, err
+}
+
+// queryServer runs the EVM executing queries through the executor
+type queryServer struct {
+	evmtypes.QueryServer
+	executor Executor
+}
+
+func (s queryServer) EthCall(goCtx context.Context, req *evmtypes.EthCallRequest) (res *evmtypes.MsgEthereumTxResponse, err error) {
+	s.executor.Execute(sdk.UnwrapSDKContext(goCtx), func(ctx sdk.Context) {
+		res, err = s.QueryServer.EthCall(sdk.WrapSDKContext(ctx), req)
+	})
+	return res, err
+}
+
+func (s queryServer) EstimateGas(goCtx context.Context, req *evmtypes.EthCallRequest) (res *evmtypes.EstimateGasResponse, err error) {
+	s.executor.Execute(sdk.UnwrapSDKContext(goCtx), func(ctx sdk.Context) {
+		res, err = s.QueryServer.EstimateGas(sdk.WrapSDKContext(ctx), req)
+	})
+	return res, err
+}
+
+func (s queryServer) TraceTx(goCtx context.Context, req *evmtypes.QueryTraceTxRequest) (res *evmtypes.QueryTraceTxResponse, err error) {
+	s.executor.Execute(sdk.UnwrapSDKContext(goCtx), func(ctx sdk.Context) {
+		res, err = s.QueryServer.TraceTx(sdk.WrapSDKContext(ctx), req)
+	})
+	return res, err
+}
+
+func (s queryServer) TraceBlock(goCtx context.Context, req *evmtypes.QueryTraceBlockRequest) (res *evmtypes.QueryTraceBlockResponse, err error) {
+	s.executor.Execute(sdk.UnwrapSDKContext(goCtx), func(ctx sdk.Context) {
+		res, err = s.QueryServer.TraceBlock(sdk.WrapSDKContext(ctx), req)
+	})
+	return res, err
+}
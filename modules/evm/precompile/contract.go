@@ -0,0 +1,192 @@
+package precompile
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// InputByteGas is the gas charged per byte of the input of a precompiled contract call, on top
+// of the fixed gas of the called method
+const InputByteGas = 16
+
+// CosmosEvent is the EVM log event mirroring a Cosmos event emitted by a precompiled contract call:
+//
+//	event CosmosEvent(string indexed eventType, string[] attributeKeys, string[] attributeValues);
+var CosmosEvent = abi.NewEvent("CosmosEvent", "CosmosEvent", false, abi.Arguments{
+	newArgument("string eventType", true),
+	newArgument("string[] attributeKeys", false),
+	newArgument("string[] attributeValues", false),
+})
+
+// revertSelector is the selector of the Solidity Error(string) revert reason
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// Method is a method of a precompiled contract, either read only if it has a Query or
+// state changing if it has a Msg
+type Method struct {
+	Name    string
+	Inputs  abi.Arguments
+	Outputs abi.Arguments
+	// Gas is the fixed gas of the method, charged along with the gas its message or query consumes
+	Gas uint64
+
+	// Query returns the outputs of the read only method
+	Query func(ctx sdk.Context, args []interface{}) ([]interface{}, error)
+	// Msg returns the message sent by the caller of the state changing method
+	Msg func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error)
+	// Result returns the outputs of the state changing method from the result of its message,
+	// none if nil
+	Result func(res *sdk.Result) ([]interface{}, error)
+}
+
+// ReadOnly returns true if the method does not change the state
+func (m Method) ReadOnly() bool {
+	return m.Query != nil
+}
+
+// Contract is a precompiled contract exposing module methods to the EVM at a fixed address
+type Contract struct {
+	name    string
+	address common.Address
+	abi     abi.ABI
+	methods map[string]Method
+}
+
+// NewContract returns the precompiled contract of the given module at the given address,
+// whose ABI is made of the given methods and the CosmosEvent event
+func NewContract(name string, address common.Address, methods ...Method) *Contract {
+	c := &Contract{
+		name:    name,
+		address: address,
+		abi: abi.ABI{
+			Methods: make(map[string]abi.Method, len(methods)),
+			Events:  map[string]abi.Event{CosmosEvent.Name: CosmosEvent},
+		},
+		methods: make(map[string]Method, len(methods)),
+	}
+	for _, m := range methods {
+		if (m.Query == nil) == (m.Msg == nil) {
+			panic(fmt.Errorf("the %s method %s must have either a query or a msg", name, m.Name))
+		}
+		mutability := "nonpayable"
+		if m.ReadOnly() {
+			mutability = "view"
+		}
+		c.abi.Methods[m.Name] = abi.NewMethod(m.Name, m.Name, abi.Function, mutability, m.ReadOnly(), false, m.Inputs, m.Outputs)
+		c.methods[m.Name] = m
+	}
+	return c
+}
+
+// Name returns the name of the module exposed by the contract
+func (c *Contract) Name() string {
+	return c.name
+}
+
+// Address returns the address of the contract
+func (c *Contract) Address() common.Address {
+	return c.address
+}
+
+// ABI returns the ABI of the contract
+func (c *Contract) ABI() abi.ABI {
+	return c.abi
+}
+
+// method returns the method called with the given input and its arguments
+func (c *Contract) method(input []byte) (Method, []interface{}, error) {
+	if len(input) < 4 {
+		return Method{}, nil, fmt.Errorf("no method selector")
+	}
+	am, err := c.abi.MethodById(input[:4])
+	if err != nil {
+		return Method{}, nil, err
+	}
+	args, err := am.Inputs.Unpack(input[4:])
+	if err != nil {
+		return Method{}, nil, fmt.Errorf("invalid %s arguments: %w", am.Name, err)
+	}
+	return c.methods[am.Name], args, nil
+}
+
+// requiredGas returns the gas of the call with the given input
+func (c *Contract) requiredGas(input []byte) uint64 {
+	gas := uint64(len(input)) * InputByteGas
+	if len(input) >= 4 {
+		if am, err := c.abi.MethodById(input[:4]); err == nil {
+			gas += c.methods[am.Name].Gas
+		}
+	}
+	return gas
+}
+
+// eventLog returns the EVM log mirroring the Cosmos event
+func (c *Contract) eventLog(event sdk.Event) (*ethtypes.Log, error) {
+	keys := make([]string, len(event.Attributes))
+	values := make([]string, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		keys[i] = string(attr.Key)
+		values[i] = string(attr.Value)
+	}
+	data, err := CosmosEvent.Inputs.NonIndexed().Pack(keys, values)
+	if err != nil {
+		return nil, err
+	}
+	return &ethtypes.Log{
+		Address: c.address,
+		Topics:  []common.Hash{CosmosEvent.ID, crypto.Keccak256Hash([]byte(event.Type))},
+		Data:    data,
+	}, nil
+}
+
+// revert returns the output of a call reverted with the given error as reason
+func revert(err error) []byte {
+	reason, _ := abi.Arguments{newArgument("string reason", false)}.Pack(err.Error())
+	return append(append([]byte{}, revertSelector...), reason...)
+}
+
+// newArgument returns the ABI argument of the given "type name" declaration
+func newArgument(decl string, indexed bool) abi.Argument {
+	fields := strings.Fields(decl)
+	typ, err := abi.NewType(fields[0], "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Argument{Name: fields[1], Type: typ, Indexed: indexed}
+}
+
+// newArguments returns the ABI arguments of the given "type name" declarations
+func newArguments(decls ...string) abi.Arguments {
+	args := make(abi.Arguments, len(decls))
+	for i, decl := range decls {
+		args[i] = newArgument(decl, false)
+	}
+	return args
+}
+
+// accAddress returns the account address of the given address argument
+func accAddress(arg interface{}) sdk.AccAddress {
+	return sdk.AccAddress(arg.(common.Address).Bytes())
+}
+
+// eventAttribute returns the value of the attribute of the first event of the given type
+// emitted by the message
+func eventAttribute(res *sdk.Result, eventType, key string) (string, error) {
+	for _, event := range res.Events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				return string(attr.Value), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no %s attribute of the %s event", key, eventType)
+}
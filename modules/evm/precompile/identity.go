@@ -0,0 +1,45 @@
+package precompile
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	identitytypes "github.com/bianjieai/iritamod/modules/identity/types"
+)
+
+// IdentityAddress is the address of the identity contract:
+//
+//	interface IIdentity {
+//	    function getIdentity(bytes id) external view returns (address owner, string credentials, string data, string[] certificates);
+//	}
+var IdentityAddress = common.HexToAddress("0x0000000000000000000000000000000000000805")
+
+// NewIdentityContract returns the read only contract of the identity module
+func NewIdentityContract(k IdentityKeeper) *Contract {
+	return NewContract(identitytypes.ModuleName, IdentityAddress,
+		Method{
+			Name:    "getIdentity",
+			Inputs:  newArguments("bytes id"),
+			Outputs: newArguments("address owner", "string credentials", "string data", "string[] certificates"),
+			Gas:     5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				id := args[0].([]byte)
+				identity, found := k.GetIdentity(ctx, id)
+				if !found {
+					return nil, fmt.Errorf("identity %X not found", id)
+				}
+				owner, err := sdk.AccAddressFromBech32(identity.Owner)
+				if err != nil {
+					return nil, err
+				}
+				certificates := identity.Certificates
+				if certificates == nil {
+					certificates = []string{}
+				}
+				return []interface{}{common.BytesToAddress(owner), identity.Credentials, identity.Data, certificates}, nil
+			},
+		},
+	)
+}
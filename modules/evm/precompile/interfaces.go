@@ -0,0 +1,69 @@
+package precompile
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	identitytypes "github.com/bianjieai/iritamod/modules/identity/types"
+	nftexported "github.com/irisnet/irismod/modules/nft/exported"
+	randomtypes "github.com/irisnet/irismod/modules/random/types"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// MsgRouter defines the expected router of the messages sent by the contracts
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// MsgFilter defines the expected keeper rejecting the messages whose type is not allowed
+type MsgFilter interface {
+	ValidateMsgs(ctx sdk.Context, msgs []sdk.Msg) error
+}
+
+// AccountFreezer defines the expected keeper of the frozen accounts
+type AccountFreezer interface {
+	IsAccountFrozen(ctx sdk.Context, address sdk.AccAddress) bool
+}
+
+// NFTKeeper defines the expected nft keeper
+type NFTKeeper interface {
+	GetNFT(ctx sdk.Context, denomID, tokenID string) (nftexported.NFT, error)
+}
+
+// MTKeeper defines the expected mt keeper
+type MTKeeper interface {
+	GetBalance(ctx sdk.Context, denomID, mtID string, addr sdk.AccAddress) uint64
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error)
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// EVMKeeper defines the expected keeper of the EVM params
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// RecordKeeper defines the expected record keeper
+type RecordKeeper interface {
+	GetRecord(ctx sdk.Context, recordID []byte) (recordtypes.Record, bool)
+}
+
+// IdentityKeeper defines the expected identity keeper
+type IdentityKeeper interface {
+	GetIdentity(ctx sdk.Context, id tmbytes.HexBytes) (identitytypes.Identity, bool)
+}
+
+// RandomKeeper defines the expected random keeper
+type RandomKeeper interface {
+	GetRandom(ctx sdk.Context, reqID []byte) (randomtypes.Random, error)
+}
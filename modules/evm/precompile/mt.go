@@ -0,0 +1,84 @@
+package precompile
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	mttypes "github.com/irisnet/irismod/modules/mt/types"
+)
+
+// MTAddress is the address of the mt contract:
+//
+//	interface IMT {
+//	    function issueDenom(string name, string data) external returns (string denomId);
+//	    function mint(string denomId, string mtId, uint64 amount, string data, address recipient) external returns (string mtId);
+//	    function transfer(string denomId, string mtId, address recipient, uint64 amount) external;
+//	    function burn(string denomId, string mtId, uint64 amount) external;
+//	    function balanceOf(string denomId, string mtId, address account) external view returns (uint64 amount);
+//	}
+//
+// Minting with an empty mtId issues a new MT.
+var MTAddress = common.HexToAddress("0x0000000000000000000000000000000000000802")
+
+// NewMTContract returns the contract of the mt module, the denoms being issued and the MTs
+// minted and held by the callers
+func NewMTContract(k MTKeeper) *Contract {
+	return NewContract(mttypes.ModuleName, MTAddress,
+		Method{
+			Name:    "issueDenom",
+			Inputs:  newArguments("string name", "string data"),
+			Outputs: newArguments("string denomId"),
+			Gas:     50000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return mttypes.NewMsgIssueDenom(args[0].(string), args[1].(string), caller.String()), nil
+			},
+			Result: func(res *sdk.Result) ([]interface{}, error) {
+				denomID, err := eventAttribute(res, mttypes.EventTypeIssueDenom, mttypes.AttributeKeyDenomID)
+				return []interface{}{denomID}, err
+			},
+		},
+		Method{
+			Name:    "mint",
+			Inputs:  newArguments("string denomId", "string mtId", "uint64 amount", "string data", "address recipient"),
+			Outputs: newArguments("string mtId"),
+			Gas:     50000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return mttypes.NewMsgMintMT(
+					args[1].(string), args[0].(string), args[2].(uint64), args[3].(string),
+					caller.String(), accAddress(args[4]).String(),
+				), nil
+			},
+			Result: func(res *sdk.Result) ([]interface{}, error) {
+				mtID, err := eventAttribute(res, mttypes.EventTypeMintMT, mttypes.AttributeKeyMTID)
+				return []interface{}{mtID}, err
+			},
+		},
+		Method{
+			Name:   "transfer",
+			Inputs: newArguments("string denomId", "string mtId", "address recipient", "uint64 amount"),
+			Gas:    30000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return mttypes.NewMsgTransferMT(
+					args[1].(string), args[0].(string), caller.String(), accAddress(args[2]).String(), args[3].(uint64),
+				), nil
+			},
+		},
+		Method{
+			Name:   "burn",
+			Inputs: newArguments("string denomId", "string mtId", "uint64 amount"),
+			Gas:    30000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return mttypes.NewMsgBurnMT(caller.String(), args[1].(string), args[0].(string), args[2].(uint64)), nil
+			},
+		},
+		Method{
+			Name:    "balanceOf",
+			Inputs:  newArguments("string denomId", "string mtId", "address account"),
+			Outputs: newArguments("uint64 amount"),
+			Gas:     5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				return []interface{}{k.GetBalance(ctx, args[0].(string), args[1].(string), accAddress(args[2]))}, nil
+			},
+		},
+	)
+}
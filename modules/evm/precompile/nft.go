@@ -0,0 +1,81 @@
+package precompile
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+)
+
+// NFTAddress is the address of the nft contract:
+//
+//	interface INFT {
+//	    function issueDenom(string id, string name, string schema, string symbol) external;
+//	    function mint(string denomId, string tokenId, string name, string uri, string data, address recipient) external;
+//	    function transfer(string denomId, string tokenId, address recipient) external;
+//	    function burn(string denomId, string tokenId) external;
+//	    function getNFT(string denomId, string tokenId) external view returns (address owner, string name, string uri, string data);
+//	}
+var NFTAddress = common.HexToAddress("0x0000000000000000000000000000000000000801")
+
+// NewNFTContract returns the contract of the nft module, the denoms being issued and the NFTs
+// minted and held by the callers
+func NewNFTContract(k NFTKeeper) *Contract {
+	return NewContract(nfttypes.ModuleName, NFTAddress,
+		Method{
+			Name:   "issueDenom",
+			Inputs: newArguments("string id", "string name", "string schema", "string symbol"),
+			Gas:    50000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return nfttypes.NewMsgIssueDenom(
+					args[0].(string), args[1].(string), args[2].(string), caller.String(), args[3].(string),
+					false, false, "", "", "", "",
+				), nil
+			},
+		},
+		Method{
+			Name:   "mint",
+			Inputs: newArguments("string denomId", "string tokenId", "string name", "string uri", "string data", "address recipient"),
+			Gas:    50000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return nfttypes.NewMsgMintNFT(
+					args[1].(string), args[0].(string), args[2].(string), args[3].(string), "", args[4].(string),
+					caller.String(), accAddress(args[5]).String(),
+				), nil
+			},
+		},
+		Method{
+			Name:   "transfer",
+			Inputs: newArguments("string denomId", "string tokenId", "address recipient"),
+			Gas:    30000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return nfttypes.NewMsgTransferNFT(
+					args[1].(string), args[0].(string),
+					nfttypes.DoNotModify, nfttypes.DoNotModify, nfttypes.DoNotModify, nfttypes.DoNotModify,
+					caller.String(), accAddress(args[2]).String(),
+				), nil
+			},
+		},
+		Method{
+			Name:   "burn",
+			Inputs: newArguments("string denomId", "string tokenId"),
+			Gas:    30000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return nfttypes.NewMsgBurnNFT(caller.String(), args[1].(string), args[0].(string)), nil
+			},
+		},
+		Method{
+			Name:    "getNFT",
+			Inputs:  newArguments("string denomId", "string tokenId"),
+			Outputs: newArguments("address owner", "string name", "string uri", "string data"),
+			Gas:     5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				nft, err := k.GetNFT(ctx, args[0].(string), args[1].(string))
+				if err != nil {
+					return nil, err
+				}
+				return []interface{}{common.BytesToAddress(nft.GetOwner()), nft.GetName(), nft.GetURI(), nft.GetData()}, nil
+			},
+		},
+	)
+}
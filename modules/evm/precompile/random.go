@@ -0,0 +1,61 @@
+package precompile
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	randomtypes "github.com/irisnet/irismod/modules/random/types"
+)
+
+// RandomAddress is the address of the random contract:
+//
+//	interface IRandom {
+//	    function requestRandom(uint64 blockInterval) external returns (bytes32 requestId);
+//	    function getRandom(bytes32 requestId) external view returns (string value, int64 height);
+//	}
+//
+// The random numbers are generated by the chain, not by the oracle, blockInterval blocks after
+// the request.
+var RandomAddress = common.HexToAddress("0x0000000000000000000000000000000000000806")
+
+// NewRandomContract returns the contract of the random module
+func NewRandomContract(k RandomKeeper) *Contract {
+	return NewContract(randomtypes.ModuleName, RandomAddress,
+		Method{
+			Name:    "requestRandom",
+			Inputs:  newArguments("uint64 blockInterval"),
+			Outputs: newArguments("bytes32 requestId"),
+			Gas:     40000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return randomtypes.NewMsgRequestRandom(caller.String(), args[0].(uint64), false, nil), nil
+			},
+			Result: func(res *sdk.Result) ([]interface{}, error) {
+				reqID, err := eventAttribute(res, randomtypes.EventTypeRequestRandom, randomtypes.AttributeKeyRequestID)
+				if err != nil {
+					return nil, err
+				}
+				id, err := hex.DecodeString(reqID)
+				if err != nil {
+					return nil, err
+				}
+				return []interface{}{common.BytesToHash(id)}, nil
+			},
+		},
+		Method{
+			Name:    "getRandom",
+			Inputs:  newArguments("bytes32 requestId"),
+			Outputs: newArguments("string value", "int64 height"),
+			Gas:     5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				id := args[0].([32]byte)
+				random, err := k.GetRandom(ctx, id[:])
+				if err != nil {
+					return nil, err
+				}
+				return []interface{}{random.Value, random.Height}, nil
+			},
+		},
+	)
+}
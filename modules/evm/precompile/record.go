@@ -0,0 +1,76 @@
+package precompile
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+)
+
+// RecordAddress is the address of the record contract:
+//
+//	interface IRecord {
+//	    function createRecord(string digest, string digestAlgo, string uri, string meta) external returns (bytes32 recordId);
+//	    function getRecord(bytes32 recordId) external view returns (address creator, string digest, string digestAlgo, string uri, string meta);
+//	}
+var RecordAddress = common.HexToAddress("0x0000000000000000000000000000000000000804")
+
+// NewRecordContract returns the contract of the record module, the records being created by
+// the callers with a single content
+func NewRecordContract(k RecordKeeper) *Contract {
+	return NewContract(recordtypes.ModuleName, RecordAddress,
+		Method{
+			Name:    "createRecord",
+			Inputs:  newArguments("string digest", "string digestAlgo", "string uri", "string meta"),
+			Outputs: newArguments("bytes32 recordId"),
+			Gas:     40000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return recordtypes.NewMsgCreateRecord([]recordtypes.Content{{
+					Digest:     args[0].(string),
+					DigestAlgo: args[1].(string),
+					URI:        args[2].(string),
+					Meta:       args[3].(string),
+				}}, caller.String()), nil
+			},
+			Result: func(res *sdk.Result) ([]interface{}, error) {
+				var resp recordtypes.MsgCreateRecordResponse
+				if err := resp.Unmarshal(res.Data); err != nil {
+					return nil, err
+				}
+				id, err := hex.DecodeString(resp.Id)
+				if err != nil {
+					return nil, err
+				}
+				return []interface{}{common.BytesToHash(id)}, nil
+			},
+		},
+		Method{
+			Name:    "getRecord",
+			Inputs:  newArguments("bytes32 recordId"),
+			Outputs: newArguments("address creator", "string digest", "string digestAlgo", "string uri", "string meta"),
+			Gas:     5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				id := args[0].([32]byte)
+				record, found := k.GetRecord(ctx, id[:])
+				if !found {
+					return nil, fmt.Errorf("record %x not found", id)
+				}
+				creator, err := sdk.AccAddressFromBech32(record.Creator)
+				if err != nil {
+					return nil, err
+				}
+				// the records created through the contract have a single content, others the first one
+				var content recordtypes.Content
+				if len(record.Contents) > 0 {
+					content = record.Contents[0]
+				}
+				return []interface{}{
+					common.BytesToAddress(creator), content.Digest, content.DigestAlgo, content.URI, content.Meta,
+				}, nil
+			},
+		},
+	)
+}
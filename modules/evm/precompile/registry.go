@@ -0,0 +1,468 @@
+package precompile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// ErrNoExecution is returned by the precompiled contracts called out of an EVM execution of the app
+var ErrNoExecution = errors.New("precompiled contract called out of an app EVM execution")
+
+// Registry holds the precompiled contracts exposing the module methods to the EVM.
+//
+// The go-ethereum precompiled contracts are resolved from its process wide tables and only get
+// their input. The tables thus hold at the address of each registered contract a stateless
+// dispatcher, shared by the registries of the process, which runs the call in the EVM execution in
+// progress: the app runs every EVM execution through Execute, which passes its context and its
+// registry to the precompiled calls, and the EVM keeper transfers the values through Transfer,
+// which provides the StateDB of the execution and the caller of the contracts. The EVM executions
+// of the process, the queries included, are serialized for the execution in progress to be the one
+// of any precompiled call, whatever the goroutine running the EVM.
+//
+// Each state changing call runs its message in a branch of the context stacked over the
+// branches of the previous calls, and mirrors its events as EVM logs. The StateDB journal
+// reverts the logs along with the call frames, so that the branch of a call whose first log
+// is reverted is discarded with the later ones, and the remaining branches are written once
+// the tx succeeds (PostTxProcessing). The messages of the contracts must not write the auth,
+// bank and evm stores, which the StateDB commits over.
+type Registry struct {
+	router    MsgRouter
+	msgFilter MsgFilter
+	freezer   AccountFreezer
+
+	contracts map[common.Address]*Contract
+}
+
+var _ evmtypes.EvmHooks = &Registry{}
+
+var (
+	// executing is held by the EVM execution in progress in the process, active
+	executing sync.Mutex
+	activeMu  sync.Mutex
+	active    *execution
+
+	// installMu guards the go-ethereum precompiled contracts tables
+	installMu sync.Mutex
+)
+
+// executionKey is the context key of the EVM execution whose context is derived from
+type executionKey struct{}
+
+// NewRegistry returns a registry sending the messages of the contracts through the given router
+func NewRegistry(router MsgRouter, msgFilter MsgFilter, freezer AccountFreezer) *Registry {
+	return &Registry{
+		router:    router,
+		msgFilter: msgFilter,
+		freezer:   freezer,
+		contracts: make(map[common.Address]*Contract),
+	}
+}
+
+// Register adds the contracts to the registry, the dispatcher of their address being installed
+// in the go-ethereum precompiled contracts of every fork, once per process
+func (r *Registry) Register(contracts ...*Contract) {
+	for _, c := range contracts {
+		if _, ok := r.contracts[c.address]; ok {
+			panic(fmt.Errorf("precompiled contract %s already registered", c.address))
+		}
+		install(c.address)
+		r.contracts[c.address] = c
+	}
+}
+
+// Contracts returns the registered contracts
+func (r *Registry) Contracts() []*Contract {
+	contracts := make([]*Contract, 0, len(r.contracts))
+	for _, c := range r.contracts {
+		contracts = append(contracts, c)
+	}
+	return contracts
+}
+
+// Execute runs the EVM execution fn in the given context, passing fn the context to run the EVM
+// in, which the precompiled calls are run in as well. Every EVM execution of the app must run
+// through Execute. The executions wait for the one in progress, except the executions nested in
+// its precompiled calls, recognized by their context derived from the one of the call.
+func (r *Registry) Execute(ctx sdk.Context, fn func(ctx sdk.Context)) {
+	outer, _ := ctx.Context().Value(executionKey{}).(*execution)
+	if outer == nil || outer != activeExecution() {
+		executing.Lock()
+		defer executing.Unlock()
+		outer = nil
+	}
+
+	exec := &execution{registry: r}
+	exec.ctx = ctx.WithContext(context.WithValue(ctx.Context(), executionKey{}, exec))
+	setActiveExecution(exec)
+	// a nested execution resumes the outer one when done
+	defer setActiveExecution(outer)
+
+	fn(exec.ctx)
+}
+
+// Transfer implements the vm.TransferFunc of the EVM keeper. Along with the transfer, it binds
+// the StateDB of the EVM execution in progress, records the caller of a contract and checks that
+// the value is not transferred from or to a frozen account, which fails the tx.
+func (r *Registry) Transfer(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+	core.Transfer(db, sender, recipient, amount)
+
+	exec := activeExecution()
+	if exec == nil || exec.registry != r {
+		return
+	}
+	// the top call of an execution transfers first, the execution of a new StateDB starts over
+	if exec.db != db {
+		exec.bind(db)
+	}
+	if _, ok := r.contracts[recipient]; ok {
+		exec.pending = &call{caller: &sender, value: amount}
+	}
+
+	// the tx fails after the EVM if a value is transferred from or to a frozen account
+	if amount.Sign() > 0 && r.freezer != nil && exec.frozen == nil {
+		for _, address := range []common.Address{sender, recipient} {
+			if r.freezer.IsAccountFrozen(exec.ctx, address.Bytes()) {
+				exec.frozen = fmt.Errorf("value transferred from or to the frozen account %s", sdk.AccAddress(address.Bytes()))
+				return
+			}
+		}
+	}
+}
+
+// PostTxProcessing implements the evm hooks, writing the branches of the state changing calls
+// of the succeeded tx and emitting their events. It fails the tx which transferred a value from
+// or to a frozen account, the EVM keeper reverting the tx.
+func (r *Registry) PostTxProcessing(ctx sdk.Context, _ common.Address, _ *common.Address, _ *ethtypes.Receipt) error {
+	exec := activeExecution()
+	if exec == nil || exec.registry != r || exec.logs == nil {
+		return nil
+	}
+	if exec.frozen != nil {
+		return exec.frozen
+	}
+
+	exec.unwind()
+	for i := len(exec.frames) - 1; i >= 0; i-- {
+		exec.frames[i].write()
+	}
+	for _, f := range exec.frames {
+		ctx.EventManager().EmitEvents(f.events)
+	}
+	exec.frames = nil
+	return nil
+}
+
+// prepare runs the call of the contract at the given address and returns its gas: the fixed gas
+// of the method and of the input bytes, and the gas consumed by the call in the context. The EVM
+// calls it right before the call, whose prepared result it then returns, or instead of it if there
+// is not enough gas left.
+func (r *Registry) prepare(exec *execution, address common.Address, input []byte) uint64 {
+	// only a CALL transfers to the contract before, not a STATICCALL, DELEGATECALL or CALLCODE
+	current := call{input: input}
+	if exec.pending != nil {
+		current.caller, current.value = exec.pending.caller, exec.pending.value
+	}
+	exec.pending = nil
+
+	c, ok := r.contracts[address]
+	if !ok {
+		exec.prepared = &prepared{input: input, err: fmt.Errorf("no precompiled contract %s registered", address)}
+		return 0
+	}
+
+	ret, gasUsed, err := r.run(exec, c, current)
+	exec.prepared = &prepared{input: input, ret: ret, err: err}
+
+	gas := c.requiredGas(input)
+	if gas+gasUsed < gas {
+		return math.MaxUint64
+	}
+	return gas + gasUsed
+}
+
+// run executes the call of the contract in a gas metered context, reverting with the error as
+// reason if it fails, and returns the gas consumed by its message or query
+func (r *Registry) run(exec *execution, c *Contract, current call) (ret []byte, gasUsed uint64, err error) {
+	if exec.logs == nil {
+		return nil, 0, ErrNoExecution
+	}
+
+	gasMeter := sdk.NewInfiniteGasMeter()
+	defer func() {
+		if p := recover(); p != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", p)
+		}
+		if err != nil {
+			ret, err = revert(err), vm.ErrExecutionReverted
+		}
+		gasUsed = gasMeter.GasConsumed()
+	}()
+
+	// the reverted call refunds the value
+	if current.value != nil && current.value.Sign() != 0 {
+		return nil, 0, fmt.Errorf("the %s contract is not payable", c.name)
+	}
+	method, args, err := c.method(current.input)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var outputs []interface{}
+	if method.ReadOnly() {
+		// the queries see the branches of the previous calls, without writing
+		ctx, _ := exec.top().CacheContext()
+		if outputs, err = method.Query(ctx.WithGasMeter(gasMeter), args); err != nil {
+			return nil, 0, err
+		}
+	} else {
+		if current.caller == nil {
+			return nil, 0, fmt.Errorf("the state changing method %s can only be called by CALL", method.Name)
+		}
+		if outputs, err = r.send(exec, c, method, *current.caller, args, gasMeter); err != nil {
+			return nil, 0, err
+		}
+	}
+	ret, err = method.Outputs.Pack(outputs...)
+	return ret, 0, err
+}
+
+// send sends the message of the state changing method on behalf of the caller, in a new branch
+// of the context metered by the given gas meter, whose events are mirrored as EVM logs
+func (r *Registry) send(
+	exec *execution, c *Contract, method Method, caller common.Address, args []interface{}, gasMeter sdk.GasMeter,
+) ([]interface{}, error) {
+	sender := sdk.AccAddress(caller.Bytes())
+	msg, err := method.Msg(sender, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	exec.unwind()
+	ctx, write := exec.top().CacheContext()
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(gasMeter)
+
+	if r.freezer != nil {
+		if r.freezer.IsAccountFrozen(ctx, sender) {
+			return nil, fmt.Errorf("the caller %s is frozen", sender)
+		}
+		// the address arguments are the recipients of the message
+		for i, input := range method.Inputs {
+			if input.Type.T != abi.AddressTy {
+				continue
+			}
+			if recipient := accAddress(args[i]); r.freezer.IsAccountFrozen(ctx, recipient) {
+				return nil, fmt.Errorf("the recipient %s is frozen", recipient)
+			}
+		}
+	}
+	if r.msgFilter != nil {
+		if err := r.msgFilter.ValidateMsgs(ctx, []sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+	}
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []interface{}
+	if method.Result != nil {
+		if outputs, err = method.Result(res); err != nil {
+			return nil, err
+		}
+	}
+
+	// the message event leads, as for the messages of the txs, so that every call has a log
+	events := sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, c.name),
+		sdk.NewAttribute(sdk.AttributeKeyAction, method.Name),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+	)}
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	logs := make([]*ethtypes.Log, len(events))
+	for i, event := range events {
+		if logs[i], err = c.eventLog(event); err != nil {
+			return nil, err
+		}
+	}
+
+	index := len(exec.logs.Logs())
+	for _, log := range logs {
+		exec.db.AddLog(log)
+	}
+	exec.frames = append(exec.frames, frame{log: logs[0], index: index, ctx: ctx, write: write, events: events})
+	return outputs, nil
+}
+
+// dispatcher is the go-ethereum precompiled contract at the address of the registered contracts,
+// running their calls in the EVM execution in progress
+type dispatcher common.Address
+
+// RequiredGas implements vm.PrecompiledContract, running the call to charge the gas it consumes
+func (d dispatcher) RequiredGas(input []byte) uint64 {
+	exec := activeExecution()
+	if exec == nil {
+		return 0
+	}
+	return exec.registry.prepare(exec, common.Address(d), input)
+}
+
+// Run implements vm.PrecompiledContract, returning the result of the call run along with its gas
+func (d dispatcher) Run(input []byte) ([]byte, error) {
+	exec := activeExecution()
+	if exec == nil || exec.prepared == nil || !sameBytes(exec.prepared.input, input) {
+		return nil, ErrNoExecution
+	}
+	prepared := exec.prepared
+	exec.prepared = nil
+	return prepared.ret, prepared.err
+}
+
+// install adds the dispatcher of the address to the go-ethereum precompiled contracts of every
+// fork, unless already added
+func install(address common.Address) {
+	installMu.Lock()
+	defer installMu.Unlock()
+
+	if p, ok := vm.PrecompiledContractsBerlin[address]; ok {
+		if _, ok := p.(dispatcher); !ok {
+			panic(fmt.Errorf("%s is a go-ethereum precompiled contract", address))
+		}
+		return
+	}
+	for _, precompiles := range []map[common.Address]vm.PrecompiledContract{
+		vm.PrecompiledContractsHomestead,
+		vm.PrecompiledContractsByzantium,
+		vm.PrecompiledContractsIstanbul,
+		vm.PrecompiledContractsBerlin,
+	} {
+		precompiles[address] = dispatcher(address)
+	}
+	vm.PrecompiledAddressesHomestead = append(vm.PrecompiledAddressesHomestead, address)
+	vm.PrecompiledAddressesByzantium = append(vm.PrecompiledAddressesByzantium, address)
+	vm.PrecompiledAddressesIstanbul = append(vm.PrecompiledAddressesIstanbul, address)
+	vm.PrecompiledAddressesBerlin = append(vm.PrecompiledAddressesBerlin, address)
+}
+
+// activeExecution returns the EVM execution in progress, nil if none
+func activeExecution() *execution {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	return active
+}
+
+func setActiveExecution(exec *execution) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = exec
+}
+
+// logger is the StateDB of ethermint, keeping the logs of the tx
+type logger interface {
+	Logs() []*ethtypes.Log
+}
+
+// execution is an EVM execution of the app
+type execution struct {
+	registry *Registry
+	ctx      sdk.Context
+
+	db   vm.StateDB
+	logs logger
+
+	// pending is the CALL transferring to a contract, prepared the result of the next call of a
+	// contract, run along with its gas
+	pending  *call
+	prepared *prepared
+	// frozen is the error of a value transferred from or to a frozen account
+	frozen error
+
+	frames []frame
+}
+
+// call is a call of a contract, whose caller and value are only known for a CALL
+type call struct {
+	input  []byte
+	caller *common.Address
+	value  *big.Int
+}
+
+// prepared is the result of a call of a contract
+type prepared struct {
+	input []byte
+	ret   []byte
+	err   error
+}
+
+// frame is the branch of the context of a state changing call
+type frame struct {
+	// log is the first log of the call, at the index of the logs of the tx
+	log   *ethtypes.Log
+	index int
+
+	ctx    sdk.Context
+	write  func()
+	events sdk.Events
+}
+
+// bind binds the execution to the StateDB of a new tx
+func (exec *execution) bind(db vm.StateDB) {
+	exec.db = db
+	exec.logs, _ = db.(logger)
+	exec.pending = nil
+	exec.prepared = nil
+	exec.frozen = nil
+	exec.frames = nil
+}
+
+// unwind discards the branches of the calls reverted by the EVM. As reverting a call frame
+// reverts the logs added since, the calls still holding their first log are the first ones.
+func (exec *execution) unwind() {
+	logs := exec.logs.Logs()
+	for len(exec.frames) > 0 {
+		f := exec.frames[len(exec.frames)-1]
+		if f.index < len(logs) && logs[f.index] == f.log {
+			return
+		}
+		exec.frames = exec.frames[:len(exec.frames)-1]
+	}
+}
+
+// top returns the context of the last state changing call, the context of the execution if none
+func (exec *execution) top() sdk.Context {
+	if len(exec.frames) == 0 {
+		return exec.ctx
+	}
+	return exec.frames[len(exec.frames)-1].ctx
+}
+
+// sameBytes returns true if both slices share the same backing bytes
+func sameBytes(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return len(a) == 0 || &a[0] == &b[0]
+}
@@ -0,0 +1,597 @@
+package precompile
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/x/evm/statedb"
+)
+
+var (
+	testAddress = common.HexToAddress("0x00000000000000000000000000000000000009ff")
+	sender      = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	// proxy forwards its calldata to the test contract by CALL, returning or reverting as it
+	proxy = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	// revertingProxy reverts after forwarding its calldata to the test contract by CALL
+	revertingProxy = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	// staticProxy forwards its calldata to the test contract by STATICCALL
+	staticProxy = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	// forwarder forwards its value to recipient by CALL
+	forwarder = common.HexToAddress("0x5000000000000000000000000000000000000005")
+	recipient = common.HexToAddress("0x6000000000000000000000000000000000000006")
+)
+
+type RegistryTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	key      sdk.StoreKey
+	registry *Registry
+	contract *Contract
+	frozen   map[string]bool
+	filtered bool
+}
+
+// the router handles the test msgs as the setting of their second signer to their first one
+func (suite *RegistryTestSuite) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		signers := msg.(*testdata.TestMsg).Signers
+		if signers[1] == "" {
+			return nil, errors.New("empty key")
+		}
+		ctx.KVStore(suite.key).Set([]byte(signers[1]), []byte(signers[0]))
+		ctx.EventManager().EmitEvent(sdk.NewEvent("set", sdk.NewAttribute("key", signers[1])))
+		return &sdk.Result{Data: []byte(signers[1]), Events: ctx.EventManager().ABCIEvents()}, nil
+	}
+}
+
+func (suite *RegistryTestSuite) ValidateMsgs(sdk.Context, []sdk.Msg) error {
+	if suite.filtered {
+		return errors.New("filtered")
+	}
+	return nil
+}
+
+func (suite *RegistryTestSuite) IsAccountFrozen(_ sdk.Context, address sdk.AccAddress) bool {
+	return suite.frozen[address.String()]
+}
+
+func (suite *RegistryTestSuite) SetupTest() {
+	suite.key = sdk.NewKVStoreKey("test")
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(suite.key, sdk.StoreTypeIAVL, nil)
+	suite.Require().NoError(ms.LoadLatestVersion())
+	suite.ctx = sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	suite.frozen = make(map[string]bool)
+	suite.filtered = false
+
+	suite.contract = NewContract("test", testAddress,
+		Method{
+			Name:    "set",
+			Inputs:  newArguments("string key"),
+			Outputs: newArguments("string key"),
+			Gas:     1000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return &testdata.TestMsg{Signers: []string{caller.String(), args[0].(string)}}, nil
+			},
+			Result: func(res *sdk.Result) ([]interface{}, error) {
+				return []interface{}{string(res.Data)}, nil
+			},
+		},
+		Method{
+			Name:    "setFor",
+			Inputs:  newArguments("address account"),
+			Outputs: newArguments("string key"),
+			Gas:     1000,
+			Msg: func(caller sdk.AccAddress, args []interface{}) (sdk.Msg, error) {
+				return &testdata.TestMsg{Signers: []string{caller.String(), accAddress(args[0]).String()}}, nil
+			},
+			Result: func(res *sdk.Result) ([]interface{}, error) {
+				return []interface{}{string(res.Data)}, nil
+			},
+		},
+		Method{
+			Name:    "get",
+			Inputs:  newArguments("string key"),
+			Outputs: newArguments("string value"),
+			Gas:     100,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				return []interface{}{string(ctx.KVStore(suite.key).Get([]byte(args[0].(string))))}, nil
+			},
+		},
+		Method{
+			Name:    "nested",
+			Inputs:  newArguments("string key"),
+			Outputs: newArguments("string value"),
+			Gas:     100,
+			// gets the value by a nested EVM execution
+			Query: func(ctx sdk.Context, args []interface{}) (outputs []interface{}, err error) {
+				suite.registry.Execute(ctx, func(ctx sdk.Context) {
+					evm, _ := suite.newEVM(ctx)
+					var ret []byte
+					ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("get", args[0]), 100000, big.NewInt(0))
+					if err == nil {
+						outputs, err = suite.contract.ABI().Unpack("get", ret)
+					}
+				})
+				return outputs, err
+			},
+		},
+	)
+	suite.registry = NewRegistry(suite, suite, suite)
+	suite.registry.Register(suite.contract)
+}
+
+func TestRegistryTestSuite(t *testing.T) {
+	suite.Run(t, new(RegistryTestSuite))
+}
+
+// newEVM returns an EVM of the registry on a new StateDB holding the proxies
+func (suite *RegistryTestSuite) newEVM(ctx sdk.Context) (*vm.EVM, *statedb.StateDB) {
+	return suite.registryEVM(suite.registry, ctx)
+}
+
+// registryEVM returns an EVM of the given registry on a new StateDB holding the proxies
+func (suite *RegistryTestSuite) registryEVM(registry *Registry, ctx sdk.Context) (*vm.EVM, *statedb.StateDB) {
+	db := statedb.New(ctx, newMockKeeper(), statedb.NewEmptyTxConfig(common.Hash{}))
+	db.SetCode(proxy, proxyCode(vm.CALL, false))
+	db.SetCode(revertingProxy, proxyCode(vm.CALL, true))
+	db.SetCode(staticProxy, proxyCode(vm.STATICCALL, false))
+	db.SetCode(forwarder, append([]byte{
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLVALUE), byte(vm.PUSH20),
+	}, append(recipient.Bytes(), byte(vm.GAS), byte(vm.CALL), byte(vm.STOP))...))
+	db.AddBalance(sender, big.NewInt(1000))
+
+	evm := vm.NewEVM(vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    registry.Transfer,
+		BlockNumber: big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    10000000,
+	}, vm.TxContext{Origin: sender, GasPrice: big.NewInt(0)}, db, params.TestChainConfig, vm.Config{})
+	return evm, db
+}
+
+func (suite *RegistryTestSuite) input(name string, args ...interface{}) []byte {
+	input, err := suite.contract.ABI().Pack(name, args...)
+	suite.Require().NoError(err)
+	return input
+}
+
+func (suite *RegistryTestSuite) get(ctx sdk.Context, key string) string {
+	return string(ctx.KVStore(suite.key).Get([]byte(key)))
+}
+
+func (suite *RegistryTestSuite) requireReverted(ret []byte, err error, reason string) {
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	msg, err := abi.UnpackRevert(ret)
+	suite.Require().NoError(err)
+	suite.Require().Contains(msg, reason)
+}
+
+func (suite *RegistryTestSuite) TestWrites() {
+	hookCtx := suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	suite.registry.Execute(suite.ctx, func(ctx sdk.Context) {
+		evm, db := suite.newEVM(ctx)
+
+		// the caller of the contract is the sender of the message
+		ret, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+		out, err := suite.contract.ABI().Unpack("set", ret)
+		suite.Require().NoError(err)
+		suite.Require().Equal("a", out[0])
+		ret, _, err = evm.Call(vm.AccountRef(sender), proxy, suite.input("set", "b"), 100000, big.NewInt(0))
+		suite.Require().NoError(err, string(ret))
+
+		// the reverted calls are discarded along with their logs
+		_, _, err = evm.Call(vm.AccountRef(sender), revertingProxy, suite.input("set", "c"), 100000, big.NewInt(0))
+		suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+
+		// the later calls see the previous ones, not the reverted ones
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "d"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("get", "b"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+		out, err = suite.contract.ABI().Unpack("get", ret)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.AccAddress(proxy.Bytes()).String(), out[0])
+
+		// a message event and a set event per call
+		logs := db.Logs()
+		suite.Require().Len(logs, 6)
+		suite.Require().Equal(CosmosEvent.ID, logs[0].Topics[0])
+		suite.Require().Equal(crypto.Keccak256Hash([]byte(sdk.EventTypeMessage)), logs[0].Topics[1])
+		suite.Require().Equal(crypto.Keccak256Hash([]byte("set")), logs[5].Topics[1])
+		values, err := CosmosEvent.Inputs.NonIndexed().Unpack(logs[5].Data)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]string{"key"}, values[0])
+		suite.Require().Equal([]string{"d"}, values[1])
+
+		// nothing is written until the tx succeeds
+		suite.Require().Empty(suite.get(suite.ctx, "a"))
+		suite.Require().NoError(suite.registry.PostTxProcessing(hookCtx, sender, &testAddress, nil))
+	})
+
+	suite.Require().Equal(sdk.AccAddress(sender.Bytes()).String(), suite.get(suite.ctx, "a"))
+	suite.Require().Equal(sdk.AccAddress(proxy.Bytes()).String(), suite.get(suite.ctx, "b"))
+	suite.Require().Empty(suite.get(suite.ctx, "c"))
+	suite.Require().Equal(sdk.AccAddress(sender.Bytes()).String(), suite.get(suite.ctx, "d"))
+
+	var set []string
+	for _, event := range hookCtx.EventManager().Events() {
+		if event.Type == "set" {
+			set = append(set, string(event.Attributes[0].Value))
+		}
+	}
+	suite.Require().Equal([]string{"a", "b", "d"}, set)
+}
+
+func (suite *RegistryTestSuite) TestDiscardedWrites() {
+	// the writes of a failed tx or of a query are discarded with its StateDB
+	suite.registry.Execute(suite.ctx, func(ctx sdk.Context) {
+		evm, _ := suite.newEVM(ctx)
+		_, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+
+		// a new StateDB starts over
+		evm, _ = suite.newEVM(ctx)
+		_, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "b"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.registry.PostTxProcessing(suite.ctx, sender, &testAddress, nil))
+	})
+	suite.Require().Empty(suite.get(suite.ctx, "a"))
+	suite.Require().NotEmpty(suite.get(suite.ctx, "b"))
+}
+
+func (suite *RegistryTestSuite) TestSerializedExecutions() {
+	type result struct {
+		txDone bool
+		err    error
+	}
+	started := make(chan struct{})
+	done := make(chan result)
+	txDone := false
+
+	suite.registry.Execute(suite.ctx, func(ctx sdk.Context) {
+		// a query waits for the tx in progress
+		go func() {
+			close(started)
+			queryCtx, _ := suite.ctx.CacheContext()
+			suite.registry.Execute(queryCtx, func(ctx sdk.Context) {
+				evm, _ := suite.newEVM(ctx)
+				_, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("get", "a"), 100000, big.NewInt(0))
+				done <- result{txDone, err}
+			})
+		}()
+		<-started
+
+		// the EVM of the tx runs on another goroutine
+		var err error
+		ran := make(chan struct{})
+		go func() {
+			defer close(ran)
+			evm, _ := suite.newEVM(ctx)
+			_, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a"), 100000, big.NewInt(0))
+		}()
+		<-ran
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.registry.PostTxProcessing(ctx, sender, &testAddress, nil))
+		txDone = true
+	})
+	suite.Require().Equal(sdk.AccAddress(sender.Bytes()).String(), suite.get(suite.ctx, "a"))
+
+	select {
+	case res := <-done:
+		suite.Require().NoError(res.err)
+		suite.Require().True(res.txDone)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("query blocked after the tx")
+	}
+	suite.Require().Nil(activeExecution())
+}
+
+func (suite *RegistryTestSuite) TestNestedExecutions() {
+	suite.registry.Execute(suite.ctx, func(ctx sdk.Context) {
+		evm, _ := suite.newEVM(ctx)
+		_, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+
+		// the nested execution sees the writes of the previous calls
+		ret, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("nested", "a"), 200000, big.NewInt(0))
+		suite.Require().NoError(err)
+		out, err := suite.contract.ABI().Unpack("nested", ret)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.AccAddress(sender.Bytes()).String(), out[0])
+
+		// the outer execution resumes
+		_, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "b"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.registry.PostTxProcessing(ctx, sender, &testAddress, nil))
+	})
+	suite.Require().NotEmpty(suite.get(suite.ctx, "a"))
+	suite.Require().NotEmpty(suite.get(suite.ctx, "b"))
+}
+
+func (suite *RegistryTestSuite) TestRegistries() {
+	// the registry of another app of the process, holding another contract at the address
+	other := NewRegistry(suite, suite, suite)
+	other.Register(NewContract("other", testAddress, Method{
+		Name:    "get",
+		Inputs:  newArguments("string key"),
+		Outputs: newArguments("string value"),
+		Gas:     100,
+		Query: func(sdk.Context, []interface{}) ([]interface{}, error) {
+			return []interface{}{"other"}, nil
+		},
+	}))
+
+	get := func(registry *Registry) interface{} {
+		var out []interface{}
+		registry.Execute(suite.ctx, func(ctx sdk.Context) {
+			evm, _ := suite.registryEVM(registry, ctx)
+			ret, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("get", "a"), 100000, big.NewInt(0))
+			suite.Require().NoError(err)
+			out, err = suite.contract.ABI().Unpack("get", ret)
+			suite.Require().NoError(err)
+
+			// the hooks of the other registries ignore the execution
+			suite.Require().NoError(suite.registry.PostTxProcessing(ctx, sender, &testAddress, nil))
+		})
+		return out[0]
+	}
+	suite.Require().Equal("other", get(other))
+	suite.Require().Equal("", get(suite.registry))
+}
+
+func (suite *RegistryTestSuite) TestRejectedCalls() {
+	suite.registry.Execute(suite.ctx, func(ctx sdk.Context) {
+		evm, db := suite.newEVM(ctx)
+
+		// the state changing methods require a CALL
+		ret, _, err := evm.Call(vm.AccountRef(sender), staticProxy, suite.input("set", "a"), 100000, big.NewInt(0))
+		suite.requireReverted(ret, err, "can only be called by CALL")
+		ret, _, err = evm.Call(vm.AccountRef(sender), staticProxy, suite.input("get", "a"), 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+
+		// the contracts are not payable
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a"), 100000, big.NewInt(1))
+		suite.requireReverted(ret, err, "not payable")
+		suite.Require().Equal(big.NewInt(1000), db.GetBalance(sender))
+
+		// the messages are validated, filtered and sent on behalf of the unfrozen callers
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", ""), 100000, big.NewInt(0))
+		suite.requireReverted(ret, err, "empty key")
+		suite.filtered = true
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a"), 100000, big.NewInt(0))
+		suite.requireReverted(ret, err, "filtered")
+		suite.filtered = false
+		suite.frozen[sdk.AccAddress(proxy.Bytes()).String()] = true
+		ret, _, err = evm.Call(vm.AccountRef(sender), proxy, suite.input("set", "a"), 100000, big.NewInt(0))
+		suite.requireReverted(ret, err, "frozen")
+		suite.frozen[sdk.AccAddress(recipient.Bytes()).String()] = true
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("setFor", recipient), 100000, big.NewInt(0))
+		suite.requireReverted(ret, err, "recipient")
+
+		// unknown methods and invalid arguments revert
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, []byte{1, 2, 3, 4}, 100000, big.NewInt(0))
+		suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+		ret, _, err = evm.Call(vm.AccountRef(sender), testAddress, suite.input("set", "a")[:8], 100000, big.NewInt(0))
+		suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+
+		// the gas depends on the method, the input size and the gas consumed by the message
+		input := suite.input("set", "a")
+		_, left, err := evm.Call(vm.AccountRef(sender), testAddress, input, 100000, big.NewInt(0))
+		suite.Require().NoError(err)
+		kvGas := storetypes.KVGasConfig().WriteCostFlat +
+			storetypes.KVGasConfig().WriteCostPerByte*uint64(len("a")+len(sdk.AccAddress(sender.Bytes()).String()))
+		suite.Require().Equal(100000-1000-uint64(len(input)*InputByteGas)-kvGas, left)
+
+		// the message of a call out of gas is discarded
+		input = suite.input("set", "e")
+		_, _, err = evm.Call(vm.AccountRef(sender), testAddress, input, 1000+uint64(len(input)*InputByteGas), big.NewInt(0))
+		suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+		suite.Require().NoError(suite.registry.PostTxProcessing(ctx, sender, &testAddress, nil))
+	})
+	suite.Require().Empty(suite.get(suite.ctx, "e"))
+
+	// out of an execution
+	evm, _ := suite.newEVM(suite.ctx)
+	_, _, err := evm.Call(vm.AccountRef(sender), testAddress, suite.input("get", "a"), 100000, big.NewInt(0))
+	suite.Require().ErrorIs(err, ErrNoExecution)
+}
+
+func (suite *RegistryTestSuite) TestFrozenTransfers() {
+	for _, tc := range []struct {
+		frozen common.Address
+		value  int64
+		err    bool
+	}{
+		{recipient, 10, true},
+		{forwarder, 10, true},
+		{recipient, 0, false},
+		{common.Address{}, 10, false},
+	} {
+		suite.frozen = map[string]bool{sdk.AccAddress(tc.frozen.Bytes()).String(): true}
+		suite.registry.Execute(suite.ctx, func(ctx sdk.Context) {
+			evm, db := suite.newEVM(ctx)
+
+			// the EVM forwards the value, the tx failing after it
+			_, _, err := evm.Call(vm.AccountRef(sender), forwarder, nil, 100000, big.NewInt(tc.value))
+			suite.Require().NoError(err)
+			suite.Require().Equal(big.NewInt(tc.value), db.GetBalance(recipient))
+
+			err = suite.registry.PostTxProcessing(ctx, sender, &forwarder, nil)
+			if tc.err {
+				suite.Require().ErrorContains(err, "frozen account")
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *RegistryTestSuite) TestRegister() {
+	suite.Require().Contains(vm.PrecompiledAddressesBerlin, testAddress)
+	suite.Require().Contains(vm.ActivePrecompiles(params.TestChainConfig.Rules(big.NewInt(1), false)), testAddress)
+	suite.Require().Panics(func() { suite.registry.Register(suite.contract) })
+
+	// a new registry shares the dispatcher of the address
+	NewRegistry(suite, suite, suite).Register(suite.contract)
+	suite.Require().Equal(dispatcher(testAddress), vm.PrecompiledContractsBerlin[testAddress])
+	count := 0
+	for _, address := range vm.PrecompiledAddressesBerlin {
+		if address == testAddress {
+			count++
+		}
+	}
+	suite.Require().Equal(1, count)
+
+	// the go-ethereum precompiled contracts are kept
+	suite.Require().Panics(func() {
+		NewRegistry(suite, suite, suite).Register(NewContract("ecrecover", common.BytesToAddress([]byte{1})))
+	})
+}
+
+func TestContractsABI(t *testing.T) {
+	suite := new(RegistryTestSuite)
+	suite.SetT(t)
+
+	for _, tc := range []struct {
+		contract *Contract
+		methods  []string
+	}{
+		{NewNFTContract(nil), []string{
+			"issueDenom(string,string,string,string)",
+			"mint(string,string,string,string,string,address)",
+			"transfer(string,string,address)",
+			"burn(string,string)",
+			"getNFT(string,string)",
+		}},
+		{NewMTContract(nil), []string{
+			"issueDenom(string,string)",
+			"mint(string,string,uint64,string,address)",
+			"transfer(string,string,address,uint64)",
+			"burn(string,string,uint64)",
+			"balanceOf(string,string,address)",
+		}},
+		{NewTokenContract(nil, nil, nil), []string{"getToken(string)", "balanceOf(string,address)"}},
+		{NewRecordContract(nil), []string{"createRecord(string,string,string,string)", "getRecord(bytes32)"}},
+		{NewIdentityContract(nil), []string{"getIdentity(bytes)"}},
+		{NewRandomContract(nil), []string{"requestRandom(uint64)", "getRandom(bytes32)"}},
+	} {
+		var sigs []string
+		for _, m := range tc.contract.ABI().Methods {
+			sigs = append(sigs, m.Sig)
+		}
+		suite.Require().ElementsMatch(tc.methods, sigs, tc.contract.Name())
+		suite.Require().Contains(tc.contract.ABI().Events, CosmosEvent.Name)
+	}
+}
+
+// proxyCode returns the code forwarding the calldata to the test contract with the given call
+// opcode, then returning or reverting as it, or reverting anyway
+func proxyCode(op vm.OpCode, revert bool) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0,
+	}
+	if op == vm.CALL {
+		code = append(code, byte(vm.PUSH1), 0)
+	}
+	code = append(code, byte(vm.PUSH20))
+	code = append(code, testAddress.Bytes()...)
+	code = append(code, byte(vm.GAS), byte(op))
+	if revert {
+		return append(code, byte(vm.POP), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT))
+	}
+	code = append(code,
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.JUMPI),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+		byte(vm.JUMPDEST),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	// the jump destination
+	code[len(code)-11] = byte(len(code) - 5)
+	return code
+}
+
+// mockKeeper is an in memory statedb keeper
+type mockKeeper struct {
+	accounts map[common.Address]statedb.Account
+	states   map[common.Address]statedb.Storage
+	codes    map[common.Hash][]byte
+}
+
+func newMockKeeper() *mockKeeper {
+	return &mockKeeper{
+		accounts: make(map[common.Address]statedb.Account),
+		states:   make(map[common.Address]statedb.Storage),
+		codes:    make(map[common.Hash][]byte),
+	}
+}
+
+func (k *mockKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	acct, ok := k.accounts[addr]
+	if !ok {
+		return nil
+	}
+	return &acct
+}
+
+func (k *mockKeeper) GetState(_ sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return k.states[addr][key]
+}
+
+func (k *mockKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
+	return k.codes[codeHash]
+}
+
+func (k *mockKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	for key, value := range k.states[addr] {
+		if !cb(key, value) {
+			return
+		}
+	}
+}
+
+func (k *mockKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	k.accounts[addr] = account
+	return nil
+}
+
+func (k *mockKeeper) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	if k.states[addr] == nil {
+		k.states[addr] = make(statedb.Storage)
+	}
+	k.states[addr][key] = common.BytesToHash(value)
+}
+
+func (k *mockKeeper) SetCode(_ sdk.Context, codeHash []byte, code []byte) {
+	k.codes[common.BytesToHash(codeHash)] = code
+}
+
+func (k *mockKeeper) DeleteAccount(_ sdk.Context, addr common.Address) error {
+	delete(k.accounts, addr)
+	delete(k.states, addr)
+	return nil
+}
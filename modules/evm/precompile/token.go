@@ -0,0 +1,60 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// TokenAddress is the address of the token contract:
+//
+//	interface IToken {
+//	    function getToken(string denom) external view returns (string symbol, string name, uint32 scale, string minUnit, uint64 initialSupply, uint64 maxSupply, bool mintable, address owner);
+//	    function balanceOf(string denom, address account) external view returns (uint256 amount);
+//	}
+var TokenAddress = common.HexToAddress("0x0000000000000000000000000000000000000803")
+
+// NewTokenContract returns the read only contract of the token module. The balances of the EVM
+// denom are those of the EVM.
+func NewTokenContract(tk TokenKeeper, bk BankKeeper, ek EVMKeeper) *Contract {
+	return NewContract(tokentypes.ModuleName, TokenAddress,
+		Method{
+			Name:   "getToken",
+			Inputs: newArguments("string denom"),
+			Outputs: newArguments(
+				"string symbol", "string name", "uint32 scale", "string minUnit",
+				"uint64 initialSupply", "uint64 maxSupply", "bool mintable", "address owner",
+			),
+			Gas: 5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				token, err := tk.GetToken(ctx, args[0].(string))
+				if err != nil {
+					return nil, err
+				}
+				return []interface{}{
+					token.GetSymbol(), token.GetName(), token.GetScale(), token.GetMinUnit(),
+					token.GetInitialSupply(), token.GetMaxSupply(), token.GetMintable(), common.BytesToAddress(token.GetOwner()),
+				}, nil
+			},
+		},
+		Method{
+			Name:    "balanceOf",
+			Inputs:  newArguments("string denom", "address account"),
+			Outputs: newArguments("uint256 amount"),
+			Gas:     5000,
+			Query: func(ctx sdk.Context, args []interface{}) ([]interface{}, error) {
+				denom := args[0].(string)
+				// the balances of the EVM denom change along the EVM execution, out of the context
+				if denom == ek.GetParams(ctx).EvmDenom {
+					return nil, fmt.Errorf("the balances of the EVM denom %s are those of the EVM", denom)
+				}
+				balance := bk.GetBalance(ctx, accAddress(args[1]), denom)
+				return []interface{}{new(big.Int).Set(balance.Amount.BigInt())}, nil
+			},
+		},
+	)
+}
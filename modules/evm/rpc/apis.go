@@ -18,6 +18,7 @@ import (
 	"github.com/tharsis/ethermint/rpc/ethereum/types"
 
 	"github.com/bianjieai/irita/modules/evm/rpc/ethereum/backend"
+	iritaeth "github.com/bianjieai/irita/modules/evm/rpc/ethereum/namespaces/eth"
 )
 
 // RPC namespaces and API version
@@ -33,6 +34,9 @@ const (
 	apiVersion = "1.0"
 )
 
+// JSONRPCBlockContractCalls defines if eth_call and eth_estimateGas reject the calls to blocked contracts
+const JSONRPCBlockContractCalls = "json-rpc.block-contract-calls"
+
 // GetRPCAPIs returns the list of all APIs
 func GetRPCAPIs(ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient, selectedAPIs []string) []rpc.API {
 	nonceLock := new(types.AddrLocker)
@@ -45,11 +49,16 @@ func GetRPCAPIs(ctx *server.Context, clientCtx client.Context, tmWSClient *rpccl
 	for index := range selectedAPIs {
 		switch selectedAPIs[index] {
 		case EthNamespace:
+			var ethAPI interface{} = eth.NewPublicAPI(ctx.Logger, clientCtx, evmBackend, nonceLock)
+			if ctx.Viper.GetBool(JSONRPCBlockContractCalls) {
+				ethAPI = iritaeth.NewPublicAPI(ctx.Logger, clientCtx, evmBackend, nonceLock)
+			}
+
 			apis = append(apis,
 				rpc.API{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   eth.NewPublicAPI(ctx.Logger, clientCtx, evmBackend, nonceLock),
+					Service:   ethAPI,
 					Public:    true,
 				},
 				rpc.API{
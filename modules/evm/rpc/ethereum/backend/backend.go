@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -22,19 +23,21 @@ import (
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	"github.com/bianjieai/irita/modules/evm/crypto"
+	sm2types "github.com/bianjieai/irita/modules/sm2/types"
 )
 
 type EVMWBackend struct {
 	*backend.EVMBackend
-	ctx         *client.Context
-	queryClient *types.QueryClient
-	logger      log.Logger
+	ctx            *client.Context
+	queryClient    *types.QueryClient
+	sm2QueryClient sm2types.QueryClient
+	logger         log.Logger
 }
 
 func NewEVMWBackend(ctx *server.Context, logger log.Logger, clientCtx client.Context) *EVMWBackend {
 	evmBackend := backend.NewEVMBackend(ctx, logger, clientCtx)
 
-	return &EVMWBackend{evmBackend, &clientCtx, types.NewQueryClient(clientCtx), logger}
+	return &EVMWBackend{evmBackend, &clientCtx, types.NewQueryClient(clientCtx), sm2types.NewQueryClient(clientCtx), logger}
 }
 
 func (e *EVMWBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
@@ -58,20 +61,26 @@ func (e *EVMWBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Has
 		return common.Hash{}, err
 	}
 
-	signer := crypto.NewSm2Signer(e.ChainConfig().ChainID)
+	bn, err := e.BlockNumber()
+	if err != nil {
+		e.logger.Debug("failed to fetch latest block number", "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	// the SM2 keys sign the txs of the types enabled at the latest block, as the ethereum keys
 	if info.GetAlgo() == ethsecp256k1.KeyType {
-		// eth
-		fmt.Println("SendTransaction", info.GetAlgo())
-		bn, err := e.BlockNumber()
-		if err != nil {
-			e.logger.Debug("failed to fetch latest block number", "error", err.Error())
+		err = msg.Sign(ethtypes.MakeSigner(e.ChainConfig(), new(big.Int).SetUint64(uint64(bn))), e.ctx.Keyring)
+	} else {
+		// hashed with the algorithm of the sm2 module params, which apply to the next block
+		var res *sm2types.QueryParamsResponse
+		if res, err = e.sm2QueryClient.Params(context.Background(), &sm2types.QueryParamsRequest{}); err != nil {
+			e.logger.Error("failed to query sm2 params", "error", err.Error())
 			return common.Hash{}, err
 		}
-		signer = ethtypes.MakeSigner(e.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+		hashAlgo := crypto.HashAlgo(res.Params.EvmTxHashAlgo)
+		err = signSm2(msg, crypto.MakeSm2Signer(e.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), hashAlgo), e.ctx.Keyring)
 	}
-
-	// Sign transaction
-	if err := msg.Sign(signer, e.ctx.Keyring); err != nil {
+	if err != nil {
 		e.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
@@ -135,3 +144,24 @@ func (e *EVMWBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Has
 	// Return transaction hash
 	return txHash, nil
 }
+
+// signSm2 signs the tx with the SM2 key of the sender, as MsgEthereumTx.Sign, the signature
+// carrying the pubkey of the sender
+func signSm2(msg *evmtypes.MsgEthereumTx, signer crypto.Sm2Signer, keyringSigner keyring.Signer) error {
+	from := msg.GetFrom()
+	if from.Empty() {
+		return fmt.Errorf("sender address not defined for message")
+	}
+
+	tx := msg.AsTransaction()
+	sig, pubKey, err := keyringSigner.SignByAddress(from, signer.Hash(tx).Bytes())
+	if err != nil {
+		return err
+	}
+
+	tx, err = tx.WithSignature(signer, append(sig, pubKey.Bytes()...))
+	if err != nil {
+		return err
+	}
+	return msg.FromEthereumTx(tx)
+}
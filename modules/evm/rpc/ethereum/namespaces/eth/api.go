@@ -0,0 +1,72 @@
+package eth
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
+	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/eth"
+	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// PublicAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec.
+// It rejects the simulated calls to the contracts blocked by the perm module.
+type PublicAPI struct {
+	*eth.PublicAPI
+	permQueryClient permtypes.QueryClient
+}
+
+// NewPublicAPI creates an instance of the public ETH Web3 API.
+func NewPublicAPI(
+	logger log.Logger,
+	clientCtx client.Context,
+	backend backend.Backend,
+	nonceLock *rpctypes.AddrLocker,
+) *PublicAPI {
+	return &PublicAPI{
+		PublicAPI:       eth.NewPublicAPI(logger, clientCtx, backend, nonceLock),
+		permQueryClient: permtypes.NewQueryClient(clientCtx),
+	}
+}
+
+// Call performs a raw contract call.
+func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Bytes, error) {
+	if err := e.checkContract(args.To); err != nil {
+		return nil, err
+	}
+	return e.PublicAPI.Call(args, blockNrOrHash, overrides)
+}
+
+// EstimateGas returns an estimate of gas usage for the given smart contract call.
+func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	if err := e.checkContract(args.To); err != nil {
+		return 0, err
+	}
+	return e.PublicAPI.EstimateGas(args, blockNrOptional)
+}
+
+// checkContract returns an error if the called contract is blocked
+func (e *PublicAPI) checkContract(to *common.Address) error {
+	if to == nil {
+		return nil
+	}
+
+	res, err := e.permQueryClient.BlockedContract(
+		context.Background(),
+		&permtypes.QueryBlockedContractRequest{Address: to.Hex()},
+	)
+	if err != nil {
+		return err
+	}
+	if res.Blocked {
+		return sdkerrors.Wrap(permtypes.ErrContractBlocked, to.Hex())
+	}
+	return nil
+}
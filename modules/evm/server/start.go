@@ -38,6 +38,8 @@ import (
 	"github.com/tharsis/ethermint/server/config"
 	srvflags "github.com/tharsis/ethermint/server/flags"
 	"google.golang.org/grpc"
+
+	iritaevmrpc "github.com/bianjieai/irita/modules/evm/rpc"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -155,6 +157,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Bool(iritaevmrpc.JSONRPCBlockContractCalls, false, "Define if eth_call and eth_estimateGas should reject the calls to contracts blocked by the perm module")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
 
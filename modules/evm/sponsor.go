@@ -0,0 +1,163 @@
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// sponsor fee event
+const (
+	EventTypeSponsorFee = "sponsor_fee"
+
+	AttributeKeySender   = "sender"
+	AttributeKeySponsor  = "sponsor"
+	AttributeKeyContract = "contract"
+)
+
+// ContractSponsorable defines the expected interface to get and charge the sponsors paying
+// the fees of the calls to the contracts
+type ContractSponsorable interface {
+	GetContractSponsorAddress(ctx sdk.Context, contract []byte) (sdk.AccAddress, bool)
+	UseContractSponsorFees(ctx sdk.Context, contract []byte, fee sdk.Coins) error
+}
+
+// EthFeeSponsorDecorator sets the fee payer of the ethereum txs whose fees are sponsored, either
+// by the sponsor of the called contract or by a fee grant of the fee payer given in the tx to the
+// sender. The sponsor pays the fees while the sender still pays the value of the tx.
+//
+// The allowance of the sponsor is charged the maximum fee of the tx, gas limit times gas price or
+// fee cap, as the refund of the unused gas goes to the sponsor balance. The calls to a sponsored
+// contract are paid by the sender once the spend limit of the sponsor is exceeded, while a fee
+// payer given in the tx without an allowance rejects the tx.
+//
+// The EVM keeper refunds the sender rather than the fee payer of the txs of the accounts whose
+// pubkey is neither unknown nor an ethsecp256k1 one, as the SM2 accounts. Their fees are not
+// sponsored, the txs giving a fee payer being rejected.
+//
+// It must run after EthSigVerificationDecorator, which sets the sender, and before the decorators
+// checking the balance and deducting the fees.
+type EthFeeSponsorDecorator struct {
+	evmKeeper           EVMKeeper
+	accountKeeper       AccountKeeper
+	feegrantKeeper      FeegrantKeeper
+	contractSponsorable ContractSponsorable
+}
+
+// NewEthFeeSponsorDecorator creates a new EthFeeSponsorDecorator
+func NewEthFeeSponsorDecorator(ek EVMKeeper, ak AccountKeeper, fk FeegrantKeeper, cs ContractSponsorable) EthFeeSponsorDecorator {
+	return EthFeeSponsorDecorator{
+		evmKeeper:           ek,
+		accountKeeper:       ak,
+		feegrantKeeper:      fk,
+		contractSponsorable: cs,
+	}
+}
+
+// AnteHandle charges the allowance of the sponsor of each ethereum tx and sets it as the fee payer
+func (efsd EthFeeSponsorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	evmDenom := efsd.evmKeeper.GetParams(ctx).EvmDenom
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid unpack transaction data")
+		}
+
+		if msgEthTx.FeePayer != "" && !common.IsHexAddress(msgEthTx.FeePayer) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "the fee payer %s is not a hex address", msgEthTx.FeePayer)
+		}
+
+		fee := sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewIntFromBigInt(txData.Fee())))
+		sponsor, contract, err := efsd.useSponsorFees(ctx, msgEthTx, txData, fee)
+		if err != nil {
+			return ctx, err
+		}
+		if sponsor.Empty() {
+			msgEthTx.FeePayer = ""
+			continue
+		}
+		msgEthTx.FeePayer = common.BytesToAddress(sponsor).Hex()
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(AttributeKeySender, msgEthTx.GetFrom().String()),
+			sdk.NewAttribute(AttributeKeySponsor, sponsor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		}
+		if contract != nil {
+			attrs = append(attrs, sdk.NewAttribute(AttributeKeyContract, contract.Hex()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeSponsorFee, attrs...))
+	}
+	return next(ctx, tx, simulate)
+}
+
+// useSponsorFees charges the fee to the allowance of the sponsor of the tx, if any, returning the
+// sponsor and the called contract when sponsored by the contract sponsor
+func (efsd EthFeeSponsorDecorator) useSponsorFees(
+	ctx sdk.Context, msgEthTx *evmtypes.MsgEthereumTx, txData evmtypes.TxData, fee sdk.Coins,
+) (sdk.AccAddress, *common.Address, error) {
+	if fee.IsZero() {
+		return nil, nil, nil
+	}
+
+	sender := msgEthTx.GetFrom()
+	feePayer := msgEthTx.GetFeePayer()
+	if feePayer.Equals(sender) {
+		return nil, nil, nil
+	}
+	if !efsd.refundsFeePayer(ctx, sender) {
+		if !feePayer.Empty() {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "the fees of %s, whose pubkey is not an ethsecp256k1 one, cannot be sponsored", sender)
+		}
+		return nil, nil, nil
+	}
+
+	var (
+		contractSponsor sdk.AccAddress
+		sponsored       bool
+	)
+	to := txData.GetTo()
+	if to != nil {
+		contractSponsor, sponsored = efsd.contractSponsorable.GetContractSponsorAddress(ctx, to.Bytes())
+	}
+
+	switch {
+	case feePayer.Empty():
+		// the sender pays the fees once the spend limit of the contract sponsor is exceeded
+		if !sponsored || efsd.contractSponsorable.UseContractSponsorFees(ctx, to.Bytes(), fee) != nil {
+			return nil, nil, nil
+		}
+		return contractSponsor, to, nil
+
+	case sponsored && feePayer.Equals(contractSponsor):
+		if err := efsd.contractSponsorable.UseContractSponsorFees(ctx, to.Bytes(), fee); err != nil {
+			return nil, nil, err
+		}
+		return contractSponsor, to, nil
+
+	default:
+		if err := efsd.feegrantKeeper.UseGrantedFees(ctx, feePayer, sender, fee, []sdk.Msg{msgEthTx}); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "%s is not allowed to pay the fees of %s", feePayer, sender)
+		}
+		return feePayer, nil, nil
+	}
+}
+
+// refundsFeePayer returns true if the EVM keeper refunds the unused gas of the txs of the sender
+// to their fee payer, which it only does for the accounts without pubkey or with an ethsecp256k1
+// one
+func (efsd EthFeeSponsorDecorator) refundsFeePayer(ctx sdk.Context, sender sdk.AccAddress) bool {
+	acc := efsd.accountKeeper.GetAccount(ctx, sender)
+	if acc == nil || acc.GetPubKey() == nil {
+		return true
+	}
+	return acc.GetPubKey().Type() == ethsecp256k1.KeyType
+}
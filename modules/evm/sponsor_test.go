@@ -0,0 +1,169 @@
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	sponsoredContract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	otherContract     = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	contractSponsor   = sdk.AccAddress(common.HexToAddress("0x3000000000000000000000000000000000000003").Bytes())
+	granter           = sdk.AccAddress(common.HexToAddress("0x4000000000000000000000000000000000000004").Bytes())
+)
+
+type mockEVMKeeper struct {
+	EVMKeeper
+}
+
+func (mockEVMKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return evmtypes.DefaultParams()
+}
+
+type mockAccountKeeper struct {
+	AccountKeeper
+	accounts map[string]authtypes.AccountI
+}
+
+func (k mockAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return k.accounts[addr.String()]
+}
+
+// mockFeegrantKeeper holds the spend limits granted by granter
+type mockFeegrantKeeper struct {
+	FeegrantKeeper
+	limits map[string]sdk.Coins
+}
+
+func (k mockFeegrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, _ []sdk.Msg) error {
+	limit, ok := k.limits[granter.String()+grantee.String()]
+	if !ok || !fee.IsAllLTE(limit) {
+		return errors.New("no allowance")
+	}
+	k.limits[granter.String()+grantee.String()] = limit.Sub(fee)
+	return nil
+}
+
+// mockContractSponsors sponsors the calls to the sponsored contract
+type mockContractSponsors struct {
+	limit *sdk.Coins
+}
+
+func (s mockContractSponsors) GetContractSponsorAddress(_ sdk.Context, contract []byte) (sdk.AccAddress, bool) {
+	if common.BytesToAddress(contract) != sponsoredContract {
+		return nil, false
+	}
+	return contractSponsor, true
+}
+
+func (s mockContractSponsors) UseContractSponsorFees(_ sdk.Context, _ []byte, fee sdk.Coins) error {
+	if !fee.IsAllLTE(*s.limit) {
+		return errors.New("spend limit exceeded")
+	}
+	*s.limit = s.limit.Sub(fee)
+	return nil
+}
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg {
+	return tx
+}
+
+func (tx mockTx) ValidateBasic() error {
+	return nil
+}
+
+func TestEthFeeSponsorDecorator(t *testing.T) {
+	ethKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sm2Key := sm2.GenPrivKey()
+
+	// 100000 gas at 10 per gas
+	fee := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1000000))
+
+	for _, tc := range []struct {
+		name     string
+		key      cryptotypes.PubKey
+		to       common.Address
+		feePayer sdk.AccAddress
+		limit    sdk.Coins
+		granted  sdk.Coins
+		sponsor  sdk.AccAddress
+		err      error
+	}{
+		{"contract sponsor", ethKey.PubKey(), sponsoredContract, nil, fee, nil, contractSponsor, nil},
+		{"unknown sender pubkey", nil, sponsoredContract, nil, fee, nil, contractSponsor, nil},
+		{"contract sponsor given as fee payer", ethKey.PubKey(), sponsoredContract, contractSponsor, fee, nil, contractSponsor, nil},
+		{"contract sponsor limit exceeded", ethKey.PubKey(), sponsoredContract, nil, nil, nil, nil, nil},
+		{"contract sponsor limit exceeded as fee payer", ethKey.PubKey(), sponsoredContract, contractSponsor, nil, nil, nil, errors.New("spend limit exceeded")},
+		{"not sponsored contract", ethKey.PubKey(), otherContract, nil, fee, nil, nil, nil},
+		{"fee grant", ethKey.PubKey(), otherContract, granter, nil, fee, granter, nil},
+		{"unauthorized fee payer", ethKey.PubKey(), otherContract, granter, nil, nil, nil, errors.New("is not allowed to pay the fees")},
+		{"SM2 sender of a sponsored contract", sm2Key.PubKey(), sponsoredContract, nil, fee, nil, nil, nil},
+		{"SM2 sender with fee grant", sm2Key.PubKey(), otherContract, granter, nil, fee, nil, sdkerrors.ErrUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sender := sdk.AccAddress(ethKey.PubKey().Address())
+			if tc.key != nil {
+				sender = sdk.AccAddress(tc.key.Address())
+			}
+			limit := tc.limit
+			feegrantKeeper := mockFeegrantKeeper{limits: map[string]sdk.Coins{}}
+			if tc.granted != nil {
+				feegrantKeeper.limits[granter.String()+sender.String()] = tc.granted
+			}
+			esfd := NewEthFeeSponsorDecorator(
+				mockEVMKeeper{},
+				mockAccountKeeper{accounts: map[string]authtypes.AccountI{
+					sender.String(): authtypes.NewBaseAccount(sender, tc.key, 0, 0),
+				}},
+				feegrantKeeper,
+				mockContractSponsors{limit: &limit},
+			)
+
+			msg := evmtypes.NewTx(big.NewInt(1), 0, &tc.to, big.NewInt(0), 100000, big.NewInt(10), nil, nil, nil, nil)
+			msg.From = common.BytesToAddress(sender).Hex()
+			if tc.feePayer != nil {
+				msg.FeePayer = common.BytesToAddress(tc.feePayer).Hex()
+			}
+
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			_, err := esfd.AnteHandle(ctx, mockTx{msg}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.err != nil {
+				if errors.Is(tc.err, sdkerrors.ErrUnauthorized) {
+					require.ErrorIs(t, err, tc.err)
+				} else {
+					require.ErrorContains(t, err, tc.err.Error())
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			// the refund of the unused gas goes to the fee payer
+			require.Equal(t, tc.sponsor, msg.GetFeePayer())
+			switch {
+			case tc.sponsor.Equals(contractSponsor):
+				require.True(t, limit.IsZero())
+			case tc.sponsor.Equals(granter):
+				require.True(t, feegrantKeeper.limits[granter.String()+sender.String()].IsZero())
+			default:
+				require.Equal(t, tc.limit, limit)
+				require.Empty(t, ctx.EventManager().Events())
+			}
+		})
+	}
+}
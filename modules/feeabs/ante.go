@@ -0,0 +1,150 @@
+package feeabs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bianjieai/irita/modules/feeabs/keeper"
+)
+
+// MempoolFeeDecorator checks, as the sdk one, that the tx fee is at least the
+// validator min gas prices times the gas limit. A fee paid in a fee token is also
+// accepted if its native equivalent, at the oracle price, is at least the native
+// min gas price times the gas limit.
+// Note this only applies when ctx.CheckTx = true
+type MempoolFeeDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewMempoolFeeDecorator creates a new MempoolFeeDecorator
+func NewMempoolFeeDecorator(k keeper.Keeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// fee = ceil(minGasPrice * gasLimit)
+	feeCoins := feeTx.GetFee()
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdk.NewDec(int64(feeTx.GetGas()))
+	for i, gp := range minGasPrices {
+		requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+	if feeCoins.IsAnyGTE(requiredFees) {
+		return next(ctx, tx, simulate)
+	}
+
+	feeToken, ok := mfd.keeper.GetFeeTokenOf(ctx, feeCoins)
+	if !ok {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+	}
+
+	nativeFee, err := mfd.keeper.ConvertToNative(ctx, feeToken, feeCoins[0])
+	if err != nil {
+		return ctx, err
+	}
+	if !sdk.NewCoins(nativeFee).IsAnyGTE(requiredFees) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s worth %s required: %s", feeCoins, nativeFee, requiredFees,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator deducts, as the sdk one, the fees from the fee payer or the
+// fee granter. A fee paid in a fee token is priced by its oracle feed and either
+// forwarded to the fee collector or swapped to the native token sent to it.
+// CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
+type DeductFeeDecorator struct {
+	keeper         keeper.Keeper
+	ak             ante.AccountKeeper
+	bankKeeper     authtypes.BankKeeper
+	feegrantKeeper ante.FeegrantKeeper
+}
+
+// NewDeductFeeDecorator creates a new DeductFeeDecorator
+func NewDeductFeeDecorator(k keeper.Keeper, ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		keeper:         k,
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := dfd.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return ctx, fmt.Errorf("Fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if feeGranter != nil {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			if err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs()); err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	// deduct the fees
+	if !fee.IsZero() {
+		if feeToken, ok := dfd.keeper.GetFeeTokenOf(ctx, fee); ok {
+			err = dfd.keeper.CollectFee(ctx, deductFeesFrom, feeToken, fee[0])
+		} else {
+			err = ante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
+		}
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	)}
+	ctx.EventManager().EmitEvents(events)
+
+	return next(ctx, tx, simulate)
+}
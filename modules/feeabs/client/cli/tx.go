@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/bianjieai/irita/modules/feeabs/types"
+)
+
+// FlagSwap defines the flag swapping the fees to the native token
+const FlagSwap = "swap"
+
+// NewTxCmd returns the transaction commands for the feeabs module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee abstraction transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterFeeTokenCmd(),
+		NewRemoveFeeTokenCmd(),
+	)
+
+	return txCmd
+}
+
+// NewRegisterFeeTokenCmd implements the register fee token command.
+func NewRegisterFeeTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-fee-token [denom] [feed-name]",
+		Short: "Accept a token to pay the tx fees, priced by an oracle feed",
+		Long: `Accept the min unit of a token to pay the tx fees, or update how it is priced and collected.
+The values of the oracle feed are the price of one min unit of the token in min units of the native token.
+The fees are forwarded to the fee collector, or swapped to the native token through the coinswap pools with --swap.`,
+		Example: "$ irita tx feeabs register-fee-token ubiz ubiz-uirita --swap --from=<key-name>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			swap, err := cmd.Flags().GetBool(FlagSwap)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterFeeToken(args[0], args[1], swap, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSwap, false, "swap the fees to the native token rather than forwarding them to the fee collector")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveFeeTokenCmd implements the remove fee token command.
+func NewRemoveFeeTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-fee-token [denom]",
+		Short:   "No longer accept a token to pay the tx fees",
+		Example: "$ irita tx feeabs remove-fee-token ubiz --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFeeToken(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package feeabs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/feeabs/keeper"
+	"github.com/bianjieai/irita/modules/feeabs/types"
+)
+
+// InitGenesis stores the genesis params and fee tokens
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetParams(ctx, data.Params)
+	for _, feeToken := range data.FeeTokens {
+		k.SetFeeToken(ctx, feeToken)
+	}
+}
+
+// ExportGenesis outputs the params and fee tokens
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetFeeTokens(ctx))
+}
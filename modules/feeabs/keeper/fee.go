@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	"github.com/bianjieai/irita/modules/feeabs/types"
+)
+
+// GetFeeTokenOf returns the fee token the fee is paid in, if the fee is a single
+// coin of a fee token
+func (k Keeper) GetFeeTokenOf(ctx sdk.Context, fee sdk.Coins) (types.FeeToken, bool) {
+	if len(fee) != 1 {
+		return types.FeeToken{}, false
+	}
+	return k.GetFeeToken(ctx, fee[0].Denom)
+}
+
+// GetPrice returns the price of one min unit of the fee token in min units of the
+// native token, from the latest value of its oracle feed
+func (k Keeper) GetPrice(ctx sdk.Context, feeToken types.FeeToken) (sdk.Dec, error) {
+	values := k.oracleKeeper.GetFeedValues(ctx, feeToken.FeedName)
+	if len(values) == 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPrice, "the feed %s has no value", feeToken.FeedName)
+	}
+
+	// the values are ordered from the latest, whose age is measured with the block
+	// time for the conversion to be deterministic
+	value := values[0]
+	if age := ctx.BlockTime().Sub(value.Timestamp); age > k.GetParams(ctx).MaxPriceAge {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPrice, "the value of the feed %s expired %s ago", feeToken.FeedName, age)
+	}
+
+	price, err := sdk.NewDecFromStr(value.Data)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPrice, "invalid value %s of the feed %s", value.Data, feeToken.FeedName)
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPrice, "the value of the feed %s must be positive: %s", feeToken.FeedName, price)
+	}
+	return price, nil
+}
+
+// ConvertToNative returns the amount of native token the fee is worth, rounded down
+func (k Keeper) ConvertToNative(ctx sdk.Context, feeToken types.FeeToken, fee sdk.Coin) (sdk.Coin, error) {
+	price, err := k.GetPrice(ctx, feeToken)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(k.nativeDenom, price.MulInt(fee.Amount).TruncateInt()), nil
+}
+
+// CollectFee sends the fee paid in the fee token to the fee collector, swapped to
+// the native token if the fee token is swapped. The swap fails if the native
+// tokens received are lower than the oracle price minus the max slippage.
+func (k Keeper) CollectFee(ctx sdk.Context, payer sdk.AccAddress, feeToken types.FeeToken, fee sdk.Coin) error {
+	nativeFee, err := k.ConvertToNative(ctx, feeToken, fee)
+	if err != nil {
+		return err
+	}
+
+	if !feeToken.Swap {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, k.feeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+	} else {
+		if err := k.swapFee(ctx, payer, fee, nativeFee); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyDenom, feeToken.Denom),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeFee, nativeFee.String()),
+			sdk.NewAttribute(types.AttributeKeySwap, strconv.FormatBool(feeToken.Swap)),
+		),
+	)
+	return nil
+}
+
+// swapFee swaps the fee to at least the native fee minus the max slippage, sent
+// to the fee collector
+func (k Keeper) swapFee(ctx sdk.Context, payer sdk.AccAddress, fee, nativeFee sdk.Coin) error {
+	if k.coinswapKeeper == nil {
+		return sdkerrors.Wrapf(types.ErrSwapDisabled, "the coinswap module is disabled")
+	}
+
+	slippage := k.GetParams(ctx).MaxSwapSlippage
+	minOutput := nativeFee.Amount.ToDec().Mul(sdk.OneDec().Sub(slippage)).TruncateInt()
+	if !minOutput.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "the fee %s is worth no %s", fee, k.nativeDenom)
+	}
+
+	err := k.coinswapKeeper.Swap(ctx, &coinswaptypes.MsgSwapOrder{
+		Input: coinswaptypes.Input{Address: payer.String(), Coin: fee},
+		Output: coinswaptypes.Output{
+			Address: k.accountKeeper.GetModuleAddress(k.feeCollectorName).String(),
+			Coin:    sdk.NewCoin(k.nativeDenom, minOutput),
+		},
+		Deadline:   ctx.BlockTime().Unix(),
+		IsBuyOrder: false,
+	})
+	if err != nil {
+		if sdkerrors.IsOf(err, coinswaptypes.ErrConstraintNotMet) {
+			return sdkerrors.Wrapf(types.ErrSlippageExceeded, "failed to swap %s to at least %s%s: %s", fee, minOutput, k.nativeDenom, err)
+		}
+		return sdkerrors.Wrapf(err, "failed to swap %s", fee)
+	}
+	return nil
+}
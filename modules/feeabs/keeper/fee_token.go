@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bianjieai/irita/modules/feeabs/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// RegisterFeeToken accepts the token to pay the tx fees, or updates how it is
+// priced and collected if already accepted
+func (k Keeper) RegisterFeeToken(ctx sdk.Context, feeToken types.FeeToken, operator sdk.AccAddress) error {
+	if !k.IsAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a fee admin", operator)
+	}
+	if err := feeToken.Validate(); err != nil {
+		return err
+	}
+
+	if feeToken.Denom == k.nativeDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s is the native denom", feeToken.Denom)
+	}
+	token, err := k.tokenKeeper.GetToken(ctx, feeToken.Denom)
+	if err != nil || token.GetMinUnit() != feeToken.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s is not the min unit of a token", feeToken.Denom)
+	}
+	if _, found := k.oracleKeeper.GetFeed(ctx, feeToken.FeedName); !found {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "unknown feed %s", feeToken.FeedName)
+	}
+	if feeToken.Swap && k.coinswapKeeper == nil {
+		return sdkerrors.Wrapf(types.ErrSwapDisabled, "the coinswap module is disabled")
+	}
+
+	k.SetFeeToken(ctx, feeToken)
+	return nil
+}
+
+// RemoveFeeToken no longer accepts the token to pay the tx fees
+func (k Keeper) RemoveFeeToken(ctx sdk.Context, denom string, operator sdk.AccAddress) error {
+	if !k.IsAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a fee admin", operator)
+	}
+	if _, found := k.GetFeeToken(ctx, denom); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFeeToken, "%s", denom)
+	}
+
+	k.DeleteFeeToken(ctx, denom)
+	return nil
+}
+
+// IsAdmin returns true if the account is allowed to register and remove the fee tokens
+func (k Keeper) IsAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.permKeeper.IsAuthorized(ctx, address, permtypes.RoleFeeAdmin)
+}
+
+// SetFeeToken stores the fee token
+func (k Keeper) SetFeeToken(ctx sdk.Context, feeToken types.FeeToken) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeTokenKey(feeToken.Denom), k.cdc.MustMarshal(&feeToken))
+}
+
+// GetFeeToken returns the fee token of the given denom
+func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (feeToken types.FeeToken, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeTokenKey(denom))
+	if bz == nil {
+		return feeToken, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return feeToken, true
+}
+
+// DeleteFeeToken deletes the fee token of the given denom
+func (k Keeper) DeleteFeeToken(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeTokenKey(denom))
+}
+
+// GetFeeTokens returns all the fee tokens
+func (k Keeper) GetFeeTokens(ctx sdk.Context) []types.FeeToken {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeTokenKey)
+	defer iterator.Close()
+
+	feeTokens := []types.FeeToken{}
+	for ; iterator.Valid(); iterator.Next() {
+		var feeToken types.FeeToken
+		k.cdc.MustUnmarshal(iterator.Value(), &feeToken)
+		feeTokens = append(feeTokens, feeToken)
+	}
+	return feeTokens
+}
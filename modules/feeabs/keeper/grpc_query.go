@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bianjieai/irita/modules/feeabs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeToken queries the fee token of the given denom
+func (k Keeper) FeeToken(c context.Context, req *types.QueryFeeTokenRequest) (*types.QueryFeeTokenResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "denom can not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feeToken, found := k.GetFeeToken(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee token %s doesn't exist", req.Denom)
+	}
+	return &types.QueryFeeTokenResponse{FeeToken: feeToken}, nil
+}
+
+// FeeTokens queries the tokens accepted to pay the tx fees
+func (k Keeper) FeeTokens(c context.Context, req *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeTokenKey)
+
+	var feeTokens []types.FeeToken
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var feeToken types.FeeToken
+		if err := k.cdc.Unmarshal(value, &feeToken); err != nil {
+			return err
+		}
+		feeTokens = append(feeTokens, feeToken)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeTokensResponse{FeeTokens: feeTokens, Pagination: pageRes}, nil
+}
+
+// Params queries the parameters of the feeabs module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
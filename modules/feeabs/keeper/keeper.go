@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/bianjieai/irita/modules/feeabs/types"
+)
+
+// Keeper defines the feeabs keeper
+type Keeper struct {
+	storeKey         sdk.StoreKey
+	cdc              codec.Codec
+	paramSpace       paramstypes.Subspace
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	permKeeper       types.PermKeeper
+	tokenKeeper      types.TokenKeeper
+	oracleKeeper     types.OracleKeeper
+	coinswapKeeper   types.CoinswapKeeper
+	nativeDenom      string
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feeabs Keeper instance. The fees are converted to the
+// native denom, and swapped through the coinswap keeper, nil if the coinswap
+// module is disabled.
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	paramSpace paramstypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	permKeeper types.PermKeeper,
+	tokenKeeper types.TokenKeeper,
+	oracleKeeper types.OracleKeeper,
+	coinswapKeeper types.CoinswapKeeper,
+	nativeDenom string,
+	feeCollectorName string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		permKeeper:       permKeeper,
+		tokenKeeper:      tokenKeeper,
+		oracleKeeper:     oracleKeeper,
+		coinswapKeeper:   coinswapKeeper,
+		nativeDenom:      nativeDenom,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// NativeDenom returns the denom the fee tokens are priced in
+func (k Keeper) NativeDenom() string {
+	return k.nativeDenom
+}
+
+// GetParams returns the feeabs module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the feeabs module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/bianjieai/irita/modules/feeabs/keeper"
+	"github.com/bianjieai/irita/modules/feeabs/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+const (
+	nativeDenom = "uirita"
+	tokenDenom  = "ubiz"
+	feedName    = "ubiz-uirita"
+)
+
+var (
+	admin = testutil.Addr("admin")
+	payer = testutil.Addr("payer")
+	pool  = testutil.Addr("pool")
+)
+
+// tokenKeeper holds the token of the fee token denom
+type tokenKeeper struct{}
+
+func (tokenKeeper) GetToken(_ sdk.Context, denom string) (tokentypes.TokenI, error) {
+	if denom != tokenDenom {
+		return nil, tokentypes.ErrTokenNotExists
+	}
+	return &tokentypes.Token{Symbol: "biz", MinUnit: tokenDenom, Scale: 6}, nil
+}
+
+// oracleKeeper holds the values of the fee token feed, the latest first
+type oracleKeeper struct {
+	values oracletypes.FeedValues
+}
+
+func (k *oracleKeeper) GetFeed(_ sdk.Context, name string) (oracletypes.Feed, bool) {
+	return oracletypes.Feed{FeedName: name}, name == feedName
+}
+
+func (k *oracleKeeper) GetFeedValues(_ sdk.Context, _ string) oracletypes.FeedValues {
+	return k.values
+}
+
+// coinswapKeeper swaps the fee token at a fixed rate from the pool account
+type coinswapKeeper struct {
+	bankKeeper bankkeeper.Keeper
+	rate       sdk.Dec
+}
+
+func (k *coinswapKeeper) GetStandardDenom(sdk.Context) string {
+	return nativeDenom
+}
+
+func (k *coinswapKeeper) Swap(ctx sdk.Context, msg *coinswaptypes.MsgSwapOrder) error {
+	output := sdk.NewCoin(msg.Output.Coin.Denom, k.rate.MulInt(msg.Input.Coin.Amount).TruncateInt())
+	if output.Amount.LT(msg.Output.Coin.Amount) {
+		return sdkerrors.Wrapf(coinswaptypes.ErrConstraintNotMet, "expected %s, actual %s", msg.Output.Coin, output)
+	}
+
+	input, _ := sdk.AccAddressFromBech32(msg.Input.Address)
+	recipient, _ := sdk.AccAddressFromBech32(msg.Output.Address)
+	if err := k.bankKeeper.SendCoins(ctx, input, pool, sdk.NewCoins(msg.Input.Coin)); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, pool, recipient, sdk.NewCoins(output))
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	env            *testutil.KeeperEnv
+	ctx            sdk.Context
+	keeper         keeper.Keeper
+	accountKeeper  authkeeper.AccountKeeper
+	bankKeeper     bankkeeper.Keeper
+	oracleKeeper   *oracleKeeper
+	coinswapKeeper *coinswapKeeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), map[string][]string{authtypes.FeeCollectorName: nil}, types.StoreKey)
+	suite.env = env
+	suite.ctx = env.Ctx
+	suite.accountKeeper = env.AccountKeeper
+	suite.bankKeeper = env.BankKeeper
+	env.PermKeeper.SetRole(suite.ctx, admin, permtypes.RoleFeeAdmin)
+
+	suite.oracleKeeper = &oracleKeeper{values: oracletypes.FeedValues{{Data: "0.5", Timestamp: suite.ctx.BlockTime()}}}
+	suite.coinswapKeeper = &coinswapKeeper{bankKeeper: suite.bankKeeper, rate: sdk.NewDecWithPrec(49, 2)}
+
+	suite.keeper = keeper.NewKeeper(
+		env.Cdc, env.Key(types.StoreKey), env.Subspace(types.ModuleName), suite.accountKeeper, suite.bankKeeper, env.PermKeeper,
+		tokenKeeper{}, suite.oracleKeeper, suite.coinswapKeeper, nativeDenom, authtypes.FeeCollectorName,
+	)
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+
+	env.Fund(suite.T(), payer, sdk.NewInt64Coin(tokenDenom, 1000))
+	env.Fund(suite.T(), pool, sdk.NewInt64Coin(nativeDenom, 1000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) feeCollectorBalance() sdk.Coins {
+	return suite.bankKeeper.GetAllBalances(suite.ctx, suite.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+}
+
+func (suite *KeeperTestSuite) TestRegisterFeeToken() {
+	feeToken := types.NewFeeToken(tokenDenom, feedName, false)
+
+	err := suite.keeper.RegisterFeeToken(suite.ctx, feeToken, payer)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.RegisterFeeToken(suite.ctx, types.NewFeeToken(nativeDenom, feedName, false), admin)
+	suite.ErrorIs(err, types.ErrInvalidFeeToken)
+	err = suite.keeper.RegisterFeeToken(suite.ctx, types.NewFeeToken("uother", feedName, false), admin)
+	suite.ErrorIs(err, types.ErrInvalidFeeToken)
+	err = suite.keeper.RegisterFeeToken(suite.ctx, types.NewFeeToken(tokenDenom, "unknown", false), admin)
+	suite.ErrorIs(err, types.ErrInvalidFeeToken)
+
+	suite.NoError(suite.keeper.RegisterFeeToken(suite.ctx, feeToken, admin))
+	stored, found := suite.keeper.GetFeeToken(suite.ctx, tokenDenom)
+	suite.True(found)
+	suite.Equal(feeToken, stored)
+
+	// a registered token is updated
+	feeToken.Swap = true
+	suite.NoError(suite.keeper.RegisterFeeToken(suite.ctx, feeToken, admin))
+	suite.Equal([]types.FeeToken{feeToken}, suite.keeper.GetFeeTokens(suite.ctx))
+
+	err = suite.keeper.RemoveFeeToken(suite.ctx, tokenDenom, payer)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+	suite.NoError(suite.keeper.RemoveFeeToken(suite.ctx, tokenDenom, admin))
+	err = suite.keeper.RemoveFeeToken(suite.ctx, tokenDenom, admin)
+	suite.ErrorIs(err, types.ErrUnknownFeeToken)
+	suite.Empty(suite.keeper.GetFeeTokens(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestConvertToNative() {
+	feeToken := types.NewFeeToken(tokenDenom, feedName, false)
+
+	nativeFee, err := suite.keeper.ConvertToNative(suite.ctx, feeToken, sdk.NewInt64Coin(tokenDenom, 101))
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(nativeDenom, 50), nativeFee)
+
+	// the price is measured against the block time
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultMaxPriceAge + time.Second))
+	_, err = suite.keeper.ConvertToNative(ctx, feeToken, sdk.NewInt64Coin(tokenDenom, 100))
+	suite.ErrorIs(err, types.ErrInvalidPrice)
+
+	for _, data := range []string{"0", "-1", "x"} {
+		suite.oracleKeeper.values[0].Data = data
+		_, err = suite.keeper.ConvertToNative(suite.ctx, feeToken, sdk.NewInt64Coin(tokenDenom, 100))
+		suite.ErrorIs(err, types.ErrInvalidPrice, data)
+	}
+
+	suite.oracleKeeper.values = nil
+	_, err = suite.keeper.ConvertToNative(suite.ctx, feeToken, sdk.NewInt64Coin(tokenDenom, 100))
+	suite.ErrorIs(err, types.ErrInvalidPrice)
+}
+
+func (suite *KeeperTestSuite) TestCollectFee() {
+	fee := sdk.NewInt64Coin(tokenDenom, 100)
+
+	// forwarded as is
+	suite.NoError(suite.keeper.CollectFee(suite.ctx, payer, types.NewFeeToken(tokenDenom, feedName, false), fee))
+	suite.Equal(sdk.NewCoins(fee), suite.feeCollectorBalance())
+
+	// swapped to the native token, 49 for a price of 50 within the max slippage
+	suite.NoError(suite.keeper.CollectFee(suite.ctx, payer, types.NewFeeToken(tokenDenom, feedName, true), fee))
+	suite.Equal(sdk.NewCoins(fee, sdk.NewInt64Coin(nativeDenom, 49)), suite.feeCollectorBalance())
+	suite.Equal(sdk.NewInt64Coin(tokenDenom, 800), suite.bankKeeper.GetBalance(suite.ctx, payer, tokenDenom))
+
+	suite.coinswapKeeper.rate = sdk.NewDecWithPrec(4, 1)
+	err := suite.keeper.CollectFee(suite.ctx, payer, types.NewFeeToken(tokenDenom, feedName, true), fee)
+	suite.ErrorIs(err, types.ErrSlippageExceeded)
+
+	err = suite.keeper.CollectFee(suite.ctx, payer, types.NewFeeToken(tokenDenom, feedName, false), sdk.NewInt64Coin(tokenDenom, 10000))
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// feeabs module sentinel errors
+var (
+	ErrUnauthorized     = sdkerrors.Register(ModuleName, 2, "unauthorized operation")
+	ErrInvalidFeeToken  = sdkerrors.Register(ModuleName, 3, "invalid fee token")
+	ErrUnknownFeeToken  = sdkerrors.Register(ModuleName, 4, "unknown fee token")
+	ErrInvalidPrice     = sdkerrors.Register(ModuleName, 5, "invalid fee token price")
+	ErrSwapDisabled     = sdkerrors.Register(ModuleName, 6, "fee swap disabled")
+	ErrSlippageExceeded = sdkerrors.Register(ModuleName, 7, "fee swap slippage exceeded")
+)
@@ -0,0 +1,15 @@
+package types
+
+// feeabs module event types
+const (
+	EventTypeRegisterFeeToken = "register_fee_token"
+	EventTypeRemoveFeeToken   = "remove_fee_token"
+	EventTypeConvertFee       = "convert_fee"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyDenom      = "denom"
+	AttributeKeyFeedName   = "feed_name"
+	AttributeKeySwap       = "swap"
+	AttributeKeyFee        = "fee"
+	AttributeKeyNativeFee  = "native_fee"
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// PermKeeper defines the expected perm keeper
+type PermKeeper interface {
+	IsAuthorized(ctx sdk.Context, address sdk.AccAddress, roles ...permtypes.Role) bool
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error)
+}
+
+// OracleKeeper defines the expected oracle keeper
+type OracleKeeper interface {
+	GetFeed(ctx sdk.Context, feedName string) (oracletypes.Feed, bool)
+	GetFeedValues(ctx sdk.Context, feedName string) oracletypes.FeedValues
+}
+
+// CoinswapKeeper defines the expected coinswap keeper
+type CoinswapKeeper interface {
+	GetStandardDenom(ctx sdk.Context) string
+	Swap(ctx sdk.Context, msg *coinswaptypes.MsgSwapOrder) error
+}
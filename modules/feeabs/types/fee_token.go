@@ -0,0 +1,28 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFeeToken constructs a new FeeToken instance
+func NewFeeToken(denom, feedName string, swap bool) FeeToken {
+	return FeeToken{
+		Denom:    denom,
+		FeedName: feedName,
+		Swap:     swap,
+	}
+}
+
+// Validate validates the fee token
+func (t FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidFeeToken, err.Error())
+	}
+	if len(strings.TrimSpace(t.FeedName)) == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeeToken, "feed name cannot be blank")
+	}
+	return nil
+}
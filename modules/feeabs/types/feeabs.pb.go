@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feeabs/feeabs.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeToken defines a token module denom accepted to pay the tx fees, priced by
+// an oracle feed
+type FeeToken struct {
+	// denom is the min unit of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// feed_name is the oracle feed whose values are the price of one min unit of
+	// the token in min units of the native token
+	FeedName string `protobuf:"bytes,2,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty"`
+	// swap defines whether the fees are swapped to the native token through the
+	// coinswap pools, rather than forwarded as is to the fee collector
+	Swap bool `protobuf:"varint,3,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4216fd9bfe307bf2, []int{0}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+// Params defines the parameters for the feeabs module
+type Params struct {
+	// max_price_age is the maximum age of the oracle feed value pricing the fees
+	MaxPriceAge time.Duration `protobuf:"bytes,1,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// max_swap_slippage is the maximum ratio by which the native tokens received
+	// from a fee swap may be lower than the oracle price
+	MaxSwapSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_swap_slippage,json=maxSwapSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_slippage"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4216fd9bfe307bf2, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FeeToken)(nil), "irita.feeabs.FeeToken")
+	proto.RegisterType((*Params)(nil), "irita.feeabs.Params")
+}
+
+func init() { proto.RegisterFile("feeabs/feeabs.proto", fileDescriptor_4216fd9bfe307bf2) }
+
+var fileDescriptor_4216fd9bfe307bf2 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0xae, 0xd2, 0x40,
+	0x14, 0xc7, 0x3b, 0x7a, 0xbd, 0xe9, 0x1d, 0x34, 0xc6, 0xca, 0x02, 0x31, 0x99, 0x12, 0x16, 0x86,
+	0x8d, 0x33, 0x89, 0xee, 0xd8, 0x49, 0x88, 0xae, 0x34, 0xa4, 0x98, 0x98, 0xb0, 0x69, 0x4e, 0xdb,
+	0xc3, 0x38, 0xc2, 0x74, 0x9a, 0x4e, 0x1b, 0xf0, 0x2d, 0x5c, 0xb2, 0xe4, 0x2d, 0x7c, 0x05, 0x96,
+	0x2c, 0x8d, 0x0b, 0x54, 0xd8, 0xf8, 0x18, 0xa6, 0x1f, 0x24, 0xae, 0xce, 0xd7, 0x9c, 0xff, 0xff,
+	0x37, 0x39, 0xf4, 0xe9, 0x12, 0x11, 0x22, 0x2b, 0x9a, 0xc0, 0xb3, 0xdc, 0x14, 0xc6, 0x7b, 0xa8,
+	0x72, 0x55, 0x00, 0x6f, 0x7a, 0xfd, 0xae, 0x34, 0xd2, 0xd4, 0x03, 0x51, 0x65, 0xcd, 0x9b, 0x3e,
+	0x93, 0xc6, 0xc8, 0x35, 0x8a, 0xba, 0x8a, 0xca, 0xa5, 0x48, 0xca, 0x1c, 0x0a, 0x65, 0xd2, 0x66,
+	0x3e, 0xfc, 0x44, 0xdd, 0xb7, 0x88, 0x1f, 0xcd, 0x0a, 0x53, 0xaf, 0x4b, 0x1f, 0x24, 0x98, 0x1a,
+	0xdd, 0x23, 0x03, 0x32, 0xba, 0x0b, 0x9a, 0xc2, 0x7b, 0x4e, 0xef, 0x96, 0x88, 0x49, 0x98, 0x82,
+	0xc6, 0xde, 0xbd, 0x7a, 0xe2, 0x56, 0x8d, 0x0f, 0xa0, 0xd1, 0xf3, 0xe8, 0x8d, 0xdd, 0x40, 0xd6,
+	0xbb, 0x3f, 0x20, 0x23, 0x37, 0xa8, 0xf3, 0xf1, 0xcd, 0xdf, 0xbd, 0x4f, 0x86, 0xdf, 0x09, 0xbd,
+	0x9d, 0x41, 0x0e, 0xda, 0x7a, 0xef, 0xe8, 0x23, 0x0d, 0xdb, 0x30, 0xcb, 0x55, 0x8c, 0x21, 0x48,
+	0xac, 0xf5, 0x3b, 0xaf, 0x9e, 0xf1, 0x86, 0x8d, 0x5f, 0xd9, 0xf8, 0xb4, 0x65, 0x9b, 0xb8, 0x87,
+	0x93, 0xef, 0xec, 0x7e, 0xf9, 0x24, 0xe8, 0x68, 0xd8, 0xce, 0xaa, 0xc5, 0x37, 0x12, 0xbd, 0x05,
+	0x7d, 0x52, 0x09, 0x55, 0x2e, 0xa1, 0x5d, 0xab, 0x2c, 0x03, 0xd9, 0x22, 0x4d, 0x78, 0xb5, 0xf1,
+	0xf3, 0xe4, 0xbf, 0x90, 0xaa, 0xf8, 0x5c, 0x46, 0x3c, 0x36, 0x5a, 0xc4, 0xc6, 0x6a, 0x63, 0xdb,
+	0xf0, 0xd2, 0x26, 0x2b, 0x51, 0x7c, 0xcd, 0xd0, 0xf2, 0x29, 0xc6, 0xc1, 0x63, 0x0d, 0xdb, 0xf9,
+	0x06, 0xb2, 0x79, 0x2b, 0x33, 0x76, 0x77, 0x7b, 0xdf, 0xa9, 0xc8, 0x27, 0xef, 0x0f, 0x7f, 0x98,
+	0x73, 0x38, 0x33, 0x72, 0x3c, 0x33, 0xf2, 0xfb, 0xcc, 0xc8, 0xb7, 0x0b, 0x73, 0x8e, 0x17, 0xe6,
+	0xfc, 0xb8, 0x30, 0x67, 0x21, 0xfe, 0x33, 0x88, 0x14, 0xa4, 0x5f, 0x14, 0x82, 0x12, 0xf5, 0x25,
+	0x84, 0x36, 0x49, 0xb9, 0xc6, 0xeb, 0x95, 0x1a, 0xb7, 0xe8, 0xb6, 0xfe, 0xde, 0xeb, 0x7f, 0x03,
+	0x00, 0x4c, 0x27, 0xee, 0xda, 0xc3, 0x01, 0x00, 0x00,
+}
+
+func (this *FeeToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeToken)
+	if !ok {
+		that2, ok := that.(FeeToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.FeedName != that1.FeedName {
+		return false
+	}
+	if this.Swap != that1.Swap {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if !this.MaxSwapSlippage.Equal(that1.MaxSwapSlippage) {
+		return false
+	}
+	return true
+}
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Swap {
+		i--
+		if m.Swap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeedName) > 0 {
+		i -= len(m.FeedName)
+		copy(dAtA[i:], m.FeedName)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.FeedName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSwapSlippage.Size()
+		i -= size
+		if _, err := m.MaxSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeeabs(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeabs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeabs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.Swap {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.MaxSwapSlippage.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func sovFeeabs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeabs(x uint64) (n int) {
+	return sovFeeabs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Swap = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeabs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeabs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeabs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeabs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeabs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeabs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeabs = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState constructs a new GenesisState instance
+func NewGenesisState(params Params, feeTokens []FeeToken) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		FeeTokens: feeTokens,
+	}
+}
+
+// DefaultGenesisState gets the default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []FeeToken{})
+}
+
+// ValidateGenesis validates the provided feeabs genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.FeeTokens))
+	for _, t := range data.FeeTokens {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		if seen[t.Denom] {
+			return fmt.Errorf("duplicate fee token: %s", t.Denom)
+		}
+		seen[t.Denom] = true
+	}
+	return nil
+}
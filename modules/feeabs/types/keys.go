@@ -0,0 +1,26 @@
+package types
+
+const (
+	// ModuleName is the name of the feeabs module
+	ModuleName = "feeabs"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the feeabs module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the feeabs module
+	RouterKey = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	FeeTokenKey = []byte{0x01} // prefix for the fee tokens
+)
+
+// GetFeeTokenKey gets the key for the fee token of the given denom
+// VALUE: feeabs/FeeToken
+func GetFeeTokenKey(denom string) []byte {
+	return append(FeeTokenKey, []byte(denom)...)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRegisterFeeToken = "register_fee_token" // type for MsgRegisterFeeToken
+	TypeMsgRemoveFeeToken   = "remove_fee_token"   // type for MsgRemoveFeeToken
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterFeeToken{}
+	_ sdk.Msg = &MsgRemoveFeeToken{}
+)
+
+// NewMsgRegisterFeeToken creates a new MsgRegisterFeeToken instance.
+func NewMsgRegisterFeeToken(denom, feedName string, swap bool, operator string) *MsgRegisterFeeToken {
+	return &MsgRegisterFeeToken{
+		Denom:    denom,
+		FeedName: feedName,
+		Swap:     swap,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgRegisterFeeToken) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgRegisterFeeToken) Type() string { return TypeMsgRegisterFeeToken }
+
+// ValidateBasic implements Msg.
+func (m MsgRegisterFeeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return m.FeeToken().Validate()
+}
+
+// FeeToken returns the fee token to register.
+func (m MsgRegisterFeeToken) FeeToken() FeeToken {
+	return NewFeeToken(m.Denom, m.FeedName, m.Swap)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRegisterFeeToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRegisterFeeToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveFeeToken creates a new MsgRemoveFeeToken instance.
+func NewMsgRemoveFeeToken(denom, operator string) *MsgRemoveFeeToken {
+	return &MsgRemoveFeeToken{
+		Denom:    denom,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgRemoveFeeToken) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgRemoveFeeToken) Type() string { return TypeMsgRemoveFeeToken }
+
+// ValidateBasic implements Msg.
+func (m MsgRemoveFeeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidFeeToken, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRemoveFeeToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRemoveFeeToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
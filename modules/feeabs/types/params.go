@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// feeabs params default values
+var (
+	DefaultMaxPriceAge     = 5 * time.Minute          // the validity of the oracle module service prices
+	DefaultMaxSwapSlippage = sdk.NewDecWithPrec(5, 2) // 5%
+)
+
+// Keys for parameter access
+// nolint
+var (
+	KeyMaxPriceAge     = []byte("MaxPriceAge")
+	KeyMaxSwapSlippage = []byte("MaxSwapSlippage")
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the feeabs module params
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(maxPriceAge time.Duration, maxSwapSlippage sdk.Dec) Params {
+	return Params{
+		MaxPriceAge:     maxPriceAge,
+		MaxSwapSlippage: maxSwapSlippage,
+	}
+}
+
+// ParamSetPairs implements paramstypes.ParamSet
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramstypes.NewParamSetPair(KeyMaxSwapSlippage, &p.MaxSwapSlippage, validateMaxSwapSlippage),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxPriceAge, DefaultMaxSwapSlippage)
+}
+
+// String implements stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  MaxPriceAge:     %s
+  MaxSwapSlippage: %s`, p.MaxPriceAge, p.MaxSwapSlippage)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateMaxPriceAge(p.MaxPriceAge); err != nil {
+		return err
+	}
+	return validateMaxSwapSlippage(p.MaxSwapSlippage)
+}
+
+func validateMaxPriceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max price age must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateMaxSwapSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max swap slippage must be in [0, 1): %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/bianjieai/irita/modules/msgfilter/types"
+)
+
+// NewTxCmd returns the transaction commands for the msgfilter module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Message filter transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewUpdateAllowedMsgTypesCmd(),
+		NewUpdateDeniedMsgTypesCmd(),
+	)
+
+	return txCmd
+}
+
+// NewUpdateAllowedMsgTypesCmd implements the update allowed msg types command.
+func NewUpdateAllowedMsgTypesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-allowed-msg-types [msg-type-url]...",
+		Short: "Replace the allowlist of the message types",
+		Long: `Replace the allowlist of the message types, the messages of the other types being rejected.
+All the messages not denied are allowed when no type is given.`,
+		Example: "$ irita tx msgfilter update-allowed-msg-types /cosmos.bank.v1beta1.MsgSend /irismod.nft.MsgMintNFT --from=<key-name>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAllowedMsgTypes(args, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateDeniedMsgTypesCmd implements the update denied msg types command.
+func NewUpdateDeniedMsgTypesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-denied-msg-types [msg-type-url]...",
+		Short:   "Replace the denylist of the message types",
+		Long:    "Replace the denylist of the message types, whose messages are rejected even when nested in other messages.",
+		Example: "$ irita tx msgfilter update-denied-msg-types /cosmos.bank.v1beta1.MsgMultiSend --from=<key-name>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDeniedMsgTypes(args, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,22 @@
+package msgfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/msgfilter/keeper"
+	"github.com/bianjieai/irita/modules/msgfilter/types"
+)
+
+// InitGenesis stores the genesis params
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis outputs the params
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/msgfilter/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the allowlist and denylist of the message types
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// MsgTypeAllowed queries whether the messages of the given type are allowed
+func (k Keeper) MsgTypeAllowed(c context.Context, req *types.QueryMsgTypeAllowedRequest) (*types.QueryMsgTypeAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateMsgTypeURLs([]string{req.MsgTypeUrl}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMsgTypeAllowedResponse{Allowed: k.GetParams(ctx).IsAllowed(req.MsgTypeUrl)}, nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/bianjieai/irita/modules/msgfilter/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// Keeper defines the msgfilter keeper
+type Keeper struct {
+	paramSpace paramstypes.Subspace
+	permKeeper types.PermKeeper
+}
+
+// NewKeeper creates a new msgfilter Keeper instance
+func NewKeeper(paramSpace paramstypes.Subspace, permKeeper types.PermKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+		permKeeper: permKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// GetParams returns the msgfilter module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the msgfilter module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ValidateMsgs rejects the messages, or the messages nested in them, whose type is
+// not allowed
+func (k Keeper) ValidateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	return k.GetParams(ctx).ValidateMsgs(msgs)
+}
+
+// UpdateAllowedMsgTypes replaces the allowlist of the message types on behalf of the operator
+func (k Keeper) UpdateAllowedMsgTypes(ctx sdk.Context, msgTypeURLs []string, operator sdk.AccAddress) error {
+	if !k.IsAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a msg admin", operator)
+	}
+
+	params := k.GetParams(ctx)
+	params.AllowedMsgTypes = msgTypeURLs
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// UpdateDeniedMsgTypes replaces the denylist of the message types on behalf of the operator
+func (k Keeper) UpdateDeniedMsgTypes(ctx sdk.Context, msgTypeURLs []string, operator sdk.AccAddress) error {
+	if !k.IsAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a msg admin", operator)
+	}
+
+	params := k.GetParams(ctx)
+	params.DeniedMsgTypes = msgTypeURLs
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// IsAdmin returns true if the account is allowed to update the allowlist and denylist
+func (k Keeper) IsAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.permKeeper.IsAuthorized(ctx, address, permtypes.RoleMsgAdmin)
+}
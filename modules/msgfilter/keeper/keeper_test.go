@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bianjieai/irita/modules/msgfilter/keeper"
+	"github.com/bianjieai/irita/modules/msgfilter/types"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	proposaltypes "github.com/bianjieai/irita/modules/proposal/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+var (
+	admin  = testutil.Addr("admin")
+	sender = testutil.Addr("sender")
+
+	msgSendType      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSendType = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), nil)
+	suite.ctx = env.Ctx
+
+	env.PermKeeper.SetRole(suite.ctx, admin, permtypes.RoleMsgAdmin)
+
+	suite.keeper = keeper.NewKeeper(env.Subspace(types.ModuleName), env.PermKeeper)
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestUpdateMsgTypes() {
+	err := suite.keeper.UpdateDeniedMsgTypes(suite.ctx, []string{msgMultiSendType}, sender)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.UpdateDeniedMsgTypes(suite.ctx, []string{msgMultiSendType, msgMultiSendType}, admin)
+	suite.ErrorIs(err, types.ErrInvalidMsgType)
+	err = suite.keeper.UpdateAllowedMsgTypes(suite.ctx, []string{"cosmos.bank.v1beta1.MsgSend"}, admin)
+	suite.ErrorIs(err, types.ErrInvalidMsgType)
+
+	// the filter messages cannot be denied
+	err = suite.keeper.UpdateDeniedMsgTypes(suite.ctx, []string{sdk.MsgTypeURL(&types.MsgUpdateDeniedMsgTypes{})}, admin)
+	suite.ErrorIs(err, types.ErrInvalidMsgType)
+
+	suite.NoError(suite.keeper.UpdateDeniedMsgTypes(suite.ctx, []string{msgMultiSendType}, admin))
+	suite.NoError(suite.keeper.UpdateAllowedMsgTypes(suite.ctx, []string{msgSendType}, admin))
+	suite.Equal(types.NewParams([]string{msgSendType}, []string{msgMultiSendType}), suite.keeper.GetParams(suite.ctx))
+
+	res, err := suite.keeper.MsgTypeAllowed(sdk.WrapSDKContext(suite.ctx), &types.QueryMsgTypeAllowedRequest{MsgTypeUrl: msgSendType})
+	suite.NoError(err)
+	suite.True(res.Allowed)
+	res, err = suite.keeper.MsgTypeAllowed(sdk.WrapSDKContext(suite.ctx), &types.QueryMsgTypeAllowedRequest{MsgTypeUrl: msgMultiSendType})
+	suite.NoError(err)
+	suite.False(res.Allowed)
+}
+
+func (suite *KeeperTestSuite) TestValidateMsgs() {
+	send := banktypes.NewMsgSend(sender, admin, sdk.NewCoins(sdk.NewInt64Coin("uirita", 1)))
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin("uirita", 1)))},
+		[]banktypes.Output{banktypes.NewOutput(admin, sdk.NewCoins(sdk.NewInt64Coin("uirita", 1)))},
+	)
+	exec := authz.NewMsgExec(admin, []sdk.Msg{multiSend})
+	proposal, err := proposaltypes.NewMsgSubmitProposal([]sdk.Msg{&exec}, "title", "description", admin.String())
+	suite.Require().NoError(err)
+
+	// all the messages are allowed by default
+	suite.NoError(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{send, multiSend, &exec, proposal}))
+
+	// the denied messages are rejected, even when nested
+	suite.keeper.SetParams(suite.ctx, types.NewParams(nil, []string{msgMultiSendType}))
+	suite.NoError(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{send}))
+	suite.ErrorIs(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{send, multiSend}), types.ErrMsgTypeDenied)
+	suite.ErrorIs(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{&exec}), types.ErrMsgTypeDenied)
+	suite.ErrorIs(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{proposal}), types.ErrMsgTypeDenied)
+
+	// only the allowed messages, and the filter messages, are accepted
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{msgSendType, sdk.MsgTypeURL(&exec)}, nil))
+	suite.NoError(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{send, types.NewMsgUpdateAllowedMsgTypes(nil, admin.String())}))
+	suite.NoError(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{&authz.MsgExec{Grantee: admin.String(), Msgs: exec.Msgs[:0]}}))
+	suite.ErrorIs(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{&exec}), types.ErrMsgTypeDenied)
+	suite.ErrorIs(suite.keeper.ValidateMsgs(suite.ctx, []sdk.Msg{proposal}), types.ErrMsgTypeDenied)
+}
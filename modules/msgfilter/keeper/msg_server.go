@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/msgfilter/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the msgfilter MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) UpdateAllowedMsgTypes(goCtx context.Context, msg *types.MsgUpdateAllowedMsgTypes) (*types.MsgUpdateAllowedMsgTypesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UpdateAllowedMsgTypes(ctx, msg.MsgTypeUrls, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateAllowedMsgTypes,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUpdateAllowedMsgTypesResponse{}, nil
+}
+
+func (m msgServer) UpdateDeniedMsgTypes(goCtx context.Context, msg *types.MsgUpdateDeniedMsgTypes) (*types.MsgUpdateDeniedMsgTypesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UpdateDeniedMsgTypes(ctx, msg.MsgTypeUrls, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateDeniedMsgTypes,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUpdateDeniedMsgTypesResponse{}, nil
+}
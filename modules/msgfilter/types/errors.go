@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msgfilter module sentinel errors
+var (
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 2, "unauthorized operation")
+	ErrInvalidMsgType = sdkerrors.Register(ModuleName, 3, "invalid message type")
+	ErrMsgTypeDenied  = sdkerrors.Register(ModuleName, 4, "message type not allowed")
+)
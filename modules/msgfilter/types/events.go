@@ -0,0 +1,10 @@
+package types
+
+// msgfilter module event types
+const (
+	EventTypeUpdateAllowedMsgTypes = "update_allowed_msg_types"
+	EventTypeUpdateDeniedMsgTypes  = "update_denied_msg_types"
+
+	AttributeValueCategory  = ModuleName
+	AttributeKeyMsgTypeURLs = "msg_type_urls"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxNestingDepth is the maximum depth of the messages nested in other messages
+const MaxNestingDepth = 5
+
+// msgsWrapper is implemented by the messages wrapping other messages, e.g. the authz
+// MsgExec and the proposal MsgSubmitProposal
+type msgsWrapper interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// ValidateMsgs rejects the messages, or the messages nested in them, whose type is
+// not allowed
+func (p Params) ValidateMsgs(msgs []sdk.Msg) error {
+	return p.validateMsgs(msgs, 0)
+}
+
+func (p Params) validateMsgs(msgs []sdk.Msg, depth int) error {
+	if depth > MaxNestingDepth {
+		return sdkerrors.Wrapf(ErrMsgTypeDenied, "messages nested more than %d times", MaxNestingDepth)
+	}
+
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if !p.IsAllowed(msgTypeURL) {
+			return sdkerrors.Wrapf(ErrMsgTypeDenied, "%s", msgTypeURL)
+		}
+
+		wrapper, ok := msg.(msgsWrapper)
+		if !ok {
+			continue
+		}
+		nested, err := wrapper.GetMessages()
+		if err != nil {
+			return err
+		}
+		if err := p.validateMsgs(nested, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the name of the msgfilter module
+	ModuleName = "msgfilter"
+
+	// RouterKey is the msg router key for the msgfilter module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the msgfilter module
+	QuerierRoute = ModuleName
+)
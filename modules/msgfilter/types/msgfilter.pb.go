@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: msgfilter/msgfilter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the msgfilter module
+type Params struct {
+	// allowed_msg_types are the type URLs of the only messages allowed, all the
+	// messages not denied being allowed if empty
+	AllowedMsgTypes []string `protobuf:"bytes,1,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// denied_msg_types are the type URLs of the messages rejected
+	DeniedMsgTypes []string `protobuf:"bytes,2,rep,name=denied_msg_types,json=deniedMsgTypes,proto3" json:"denied_msg_types,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3779d206682c11c9, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "irita.msgfilter.Params")
+}
+
+func init() { proto.RegisterFile("msgfilter/msgfilter.proto", fileDescriptor_3779d206682c11c9) }
+
+var fileDescriptor_3779d206682c11c9 = []byte{
+	// 214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x2d, 0x4e, 0x4f,
+	0xcb, 0xcc, 0x29, 0x49, 0x2d, 0xd2, 0x87, 0xb3, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xf8,
+	0x33, 0x8b, 0x32, 0x4b, 0x12, 0xf5, 0xe0, 0xc2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x39,
+	0x7d, 0x10, 0x0b, 0xa2, 0x4c, 0x29, 0x83, 0x8b, 0x2d, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x48,
+	0x8b, 0x4b, 0x30, 0x31, 0x27, 0x27, 0xbf, 0x3c, 0x35, 0x25, 0x3e, 0xb7, 0x38, 0x3d, 0xbe, 0xa4,
+	0xb2, 0x20, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0x33, 0x88, 0x1f, 0x2a, 0xe1, 0x5b, 0x9c,
+	0x1e, 0x02, 0x12, 0x16, 0xd2, 0xe0, 0x12, 0x48, 0x49, 0xcd, 0xcb, 0x44, 0x51, 0xca, 0x04, 0x56,
+	0xca, 0x07, 0x11, 0x87, 0xa9, 0xb4, 0xe2, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x46,
+	0xa7, 0x80, 0x13, 0x0f, 0xe5, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
+	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
+	0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x29, 0x33, 0x31,
+	0x2f, 0x2b, 0x33, 0x35, 0x31, 0x53, 0x1f, 0xec, 0x07, 0xfd, 0xdc, 0xfc, 0x94, 0xd2, 0x9c, 0xd4,
+	0x62, 0x84, 0x17, 0xf5, 0xc1, 0x36, 0x26, 0xb1, 0x81, 0xbd, 0x60, 0x0c, 0x18, 0x00, 0x98, 0xe0,
+	0xeb, 0xbd, 0x06, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AllowedMsgTypes) != len(that1.AllowedMsgTypes) {
+		return false
+	}
+	for i := range this.AllowedMsgTypes {
+		if this.AllowedMsgTypes[i] != that1.AllowedMsgTypes[i] {
+			return false
+		}
+	}
+	if len(this.DeniedMsgTypes) != len(that1.DeniedMsgTypes) {
+		return false
+	}
+	for i := range this.DeniedMsgTypes {
+		if this.DeniedMsgTypes[i] != that1.DeniedMsgTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedMsgTypes) > 0 {
+		for iNdEx := len(m.DeniedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMsgTypes[iNdEx])
+			i = encodeVarintMsgfilter(dAtA, i, uint64(len(m.DeniedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintMsgfilter(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovMsgfilter(uint64(l))
+		}
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for _, s := range m.DeniedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovMsgfilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMsgfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgfilter(x uint64) (n int) {
+	return sovMsgfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypes = append(m.DeniedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgfilter = fmt.Errorf("proto: unexpected end of group")
+)
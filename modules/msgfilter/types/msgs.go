@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateAllowedMsgTypes = "update_allowed_msg_types" // type for MsgUpdateAllowedMsgTypes
+	TypeMsgUpdateDeniedMsgTypes  = "update_denied_msg_types"  // type for MsgUpdateDeniedMsgTypes
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateAllowedMsgTypes{}
+	_ sdk.Msg = &MsgUpdateDeniedMsgTypes{}
+)
+
+// NewMsgUpdateAllowedMsgTypes creates a new MsgUpdateAllowedMsgTypes instance.
+func NewMsgUpdateAllowedMsgTypes(msgTypeURLs []string, operator string) *MsgUpdateAllowedMsgTypes {
+	return &MsgUpdateAllowedMsgTypes{
+		MsgTypeUrls: msgTypeURLs,
+		Operator:    operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgUpdateAllowedMsgTypes) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgUpdateAllowedMsgTypes) Type() string { return TypeMsgUpdateAllowedMsgTypes }
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateAllowedMsgTypes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return validateAllowedMsgTypes(m.MsgTypeUrls)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUpdateAllowedMsgTypes) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateAllowedMsgTypes) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateDeniedMsgTypes creates a new MsgUpdateDeniedMsgTypes instance.
+func NewMsgUpdateDeniedMsgTypes(msgTypeURLs []string, operator string) *MsgUpdateDeniedMsgTypes {
+	return &MsgUpdateDeniedMsgTypes{
+		MsgTypeUrls: msgTypeURLs,
+		Operator:    operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgUpdateDeniedMsgTypes) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgUpdateDeniedMsgTypes) Type() string { return TypeMsgUpdateDeniedMsgTypes }
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateDeniedMsgTypes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return validateDeniedMsgTypes(m.MsgTypeUrls)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUpdateDeniedMsgTypes) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateDeniedMsgTypes) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keys for parameter access
+// nolint
+var (
+	KeyAllowedMsgTypes = []byte("AllowedMsgTypes")
+	KeyDeniedMsgTypes  = []byte("DeniedMsgTypes")
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the msgfilter module params
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(allowedMsgTypes, deniedMsgTypes []string) Params {
+	return Params{
+		AllowedMsgTypes: allowedMsgTypes,
+		DeniedMsgTypes:  deniedMsgTypes,
+	}
+}
+
+// ParamSetPairs implements paramstypes.ParamSet
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyAllowedMsgTypes, &p.AllowedMsgTypes, validateAllowedMsgTypes),
+		paramstypes.NewParamSetPair(KeyDeniedMsgTypes, &p.DeniedMsgTypes, validateDeniedMsgTypes),
+	}
+}
+
+// DefaultParams returns a default set of parameters, allowing all the messages
+func DefaultParams() Params {
+	return NewParams([]string{}, []string{})
+}
+
+// String implements stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  AllowedMsgTypes: [%s]
+  DeniedMsgTypes:  [%s]`, strings.Join(p.AllowedMsgTypes, ","), strings.Join(p.DeniedMsgTypes, ","))
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateAllowedMsgTypes(p.AllowedMsgTypes); err != nil {
+		return err
+	}
+	return validateDeniedMsgTypes(p.DeniedMsgTypes)
+}
+
+// IsAllowed returns true if the messages of the given type are allowed
+func (p Params) IsAllowed(msgTypeURL string) bool {
+	if isUnfiltered(msgTypeURL) {
+		return true
+	}
+	if contains(p.DeniedMsgTypes, msgTypeURL) {
+		return false
+	}
+	return len(p.AllowedMsgTypes) == 0 || contains(p.AllowedMsgTypes, msgTypeURL)
+}
+
+// ValidateMsgTypeURLs validates a list of message type URLs
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, url := range msgTypeURLs {
+		if !strings.HasPrefix(url, "/") || len(strings.TrimSpace(url)) != len(url) || len(url) == 1 {
+			return sdkerrors.Wrapf(ErrInvalidMsgType, "invalid type URL %q", url)
+		}
+		if seen[url] {
+			return sdkerrors.Wrapf(ErrInvalidMsgType, "duplicate type URL %s", url)
+		}
+		seen[url] = true
+	}
+	return nil
+}
+
+func validateAllowedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateMsgTypeURLs(v)
+}
+
+func validateDeniedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := ValidateMsgTypeURLs(v); err != nil {
+		return err
+	}
+	for _, url := range v {
+		if isUnfiltered(url) {
+			return sdkerrors.Wrapf(ErrInvalidMsgType, "%s cannot be denied", url)
+		}
+	}
+	return nil
+}
+
+// isUnfiltered returns true for the messages never filtered, so that the filter can
+// always be updated
+func isUnfiltered(msgTypeURL string) bool {
+	return msgTypeURL == sdk.MsgTypeURL(&MsgUpdateAllowedMsgTypes{}) ||
+		msgTypeURL == sdk.MsgTypeURL(&MsgUpdateDeniedMsgTypes{})
+}
+
+func contains(msgTypeURLs []string, msgTypeURL string) bool {
+	for _, url := range msgTypeURLs {
+		if url == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1022 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: msgfilter/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateAllowedMsgTypes defines a message to replace the allowlist of the
+// message types, allowing all the messages not denied if empty
+type MsgUpdateAllowedMsgTypes struct {
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	Operator    string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgUpdateAllowedMsgTypes) Reset()         { *m = MsgUpdateAllowedMsgTypes{} }
+func (m *MsgUpdateAllowedMsgTypes) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedMsgTypes) ProtoMessage()    {}
+func (*MsgUpdateAllowedMsgTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93f878ef0fdd371, []int{0}
+}
+func (m *MsgUpdateAllowedMsgTypes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedMsgTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedMsgTypes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowedMsgTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedMsgTypes.Merge(m, src)
+}
+func (m *MsgUpdateAllowedMsgTypes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedMsgTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedMsgTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowedMsgTypes proto.InternalMessageInfo
+
+// MsgUpdateAllowedMsgTypesResponse defines the Msg/UpdateAllowedMsgTypes response type
+type MsgUpdateAllowedMsgTypesResponse struct {
+}
+
+func (m *MsgUpdateAllowedMsgTypesResponse) Reset()         { *m = MsgUpdateAllowedMsgTypesResponse{} }
+func (m *MsgUpdateAllowedMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedMsgTypesResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowedMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93f878ef0fdd371, []int{1}
+}
+func (m *MsgUpdateAllowedMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowedMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedMsgTypesResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowedMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowedMsgTypesResponse proto.InternalMessageInfo
+
+// MsgUpdateDeniedMsgTypes defines a message to replace the denylist of the message types
+type MsgUpdateDeniedMsgTypes struct {
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	Operator    string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgUpdateDeniedMsgTypes) Reset()         { *m = MsgUpdateDeniedMsgTypes{} }
+func (m *MsgUpdateDeniedMsgTypes) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeniedMsgTypes) ProtoMessage()    {}
+func (*MsgUpdateDeniedMsgTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93f878ef0fdd371, []int{2}
+}
+func (m *MsgUpdateDeniedMsgTypes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeniedMsgTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeniedMsgTypes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeniedMsgTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeniedMsgTypes.Merge(m, src)
+}
+func (m *MsgUpdateDeniedMsgTypes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeniedMsgTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeniedMsgTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeniedMsgTypes proto.InternalMessageInfo
+
+// MsgUpdateDeniedMsgTypesResponse defines the Msg/UpdateDeniedMsgTypes response type
+type MsgUpdateDeniedMsgTypesResponse struct {
+}
+
+func (m *MsgUpdateDeniedMsgTypesResponse) Reset()         { *m = MsgUpdateDeniedMsgTypesResponse{} }
+func (m *MsgUpdateDeniedMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeniedMsgTypesResponse) ProtoMessage()    {}
+func (*MsgUpdateDeniedMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93f878ef0fdd371, []int{3}
+}
+func (m *MsgUpdateDeniedMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeniedMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeniedMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeniedMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeniedMsgTypesResponse.Merge(m, src)
+}
+func (m *MsgUpdateDeniedMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeniedMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeniedMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeniedMsgTypesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateAllowedMsgTypes)(nil), "irita.msgfilter.MsgUpdateAllowedMsgTypes")
+	proto.RegisterType((*MsgUpdateAllowedMsgTypesResponse)(nil), "irita.msgfilter.MsgUpdateAllowedMsgTypesResponse")
+	proto.RegisterType((*MsgUpdateDeniedMsgTypes)(nil), "irita.msgfilter.MsgUpdateDeniedMsgTypes")
+	proto.RegisterType((*MsgUpdateDeniedMsgTypesResponse)(nil), "irita.msgfilter.MsgUpdateDeniedMsgTypesResponse")
+}
+
+func init() { proto.RegisterFile("msgfilter/tx.proto", fileDescriptor_b93f878ef0fdd371) }
+
+var fileDescriptor_b93f878ef0fdd371 = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x8a, 0x10, 0x35, 0x42, 0x48, 0x56, 0x11, 0x55, 0x06, 0xb7, 0x64, 0x2a, 0x4b,
+	0x0c, 0x65, 0x63, 0x03, 0xb1, 0x56, 0x42, 0x15, 0x5d, 0x58, 0x42, 0x42, 0x8c, 0x31, 0x72, 0x62,
+	0xcb, 0x76, 0x04, 0x7d, 0x0b, 0x1e, 0x81, 0xc7, 0xe9, 0xd8, 0x91, 0x11, 0x92, 0x85, 0x91, 0x47,
+	0x40, 0x4d, 0x95, 0x08, 0x50, 0x23, 0x75, 0x60, 0xb3, 0x75, 0x9f, 0xee, 0xbb, 0x3b, 0xfd, 0x10,
+	0x25, 0x86, 0xdd, 0x73, 0x61, 0xa9, 0x26, 0xf6, 0xd9, 0x57, 0x5a, 0x5a, 0x89, 0xf6, 0xb8, 0xe6,
+	0x36, 0xf4, 0xeb, 0x8a, 0xdb, 0x61, 0x92, 0xc9, 0xb2, 0x46, 0x16, 0xaf, 0x25, 0xe6, 0xdd, 0xc2,
+	0xee, 0xc8, 0xb0, 0x89, 0x8a, 0x43, 0x4b, 0xcf, 0x85, 0x90, 0x4f, 0x34, 0x1e, 0x19, 0x76, 0x3d,
+	0x55, 0xd4, 0x20, 0x0f, 0xee, 0x26, 0x86, 0x05, 0x76, 0xaa, 0x68, 0x90, 0x69, 0x61, 0xba, 0xa0,
+	0xdf, 0x1a, 0xb4, 0xc7, 0x3b, 0xc9, 0x12, 0x98, 0x68, 0x61, 0x90, 0x0b, 0xb7, 0xa5, 0xa2, 0x3a,
+	0xb4, 0x52, 0x77, 0x37, 0xfa, 0x60, 0xd0, 0x1e, 0xd7, 0xff, 0xb3, 0xcd, 0xcf, 0xd7, 0x1e, 0xf0,
+	0x3c, 0xd8, 0x6f, 0x32, 0x8c, 0xa9, 0x51, 0x32, 0x35, 0xd4, 0x0b, 0xe0, 0x41, 0xcd, 0x5c, 0xd2,
+	0x94, 0xff, 0xfb, 0x10, 0x87, 0xb0, 0xd7, 0x20, 0xa8, 0x66, 0x18, 0x7e, 0x01, 0xd8, 0x1a, 0x19,
+	0x86, 0x32, 0xb8, 0xbf, 0xfa, 0x1c, 0x47, 0xfe, 0x9f, 0x93, 0xfa, 0x4d, 0x7b, 0xb9, 0x27, 0x6b,
+	0xa3, 0x95, 0x1e, 0x69, 0xd8, 0x59, 0xb9, 0xff, 0xa0, 0xb9, 0xd5, 0x6f, 0xd2, 0x3d, 0x5e, 0x97,
+	0xac, 0x9c, 0x17, 0x57, 0xb3, 0x0f, 0xec, 0xcc, 0x72, 0x0c, 0xe6, 0x39, 0x06, 0xef, 0x39, 0x06,
+	0x2f, 0x05, 0x76, 0xe6, 0x05, 0x76, 0xde, 0x0a, 0xec, 0xdc, 0x0c, 0x19, 0xb7, 0x0f, 0x59, 0xe4,
+	0xdf, 0xc9, 0x84, 0x44, 0x3c, 0x4c, 0x1f, 0x39, 0x0d, 0x39, 0x29, 0x1d, 0x24, 0x91, 0x71, 0x26,
+	0xa8, 0x21, 0x3f, 0x82, 0xb7, 0xe8, 0x1c, 0x6d, 0x95, 0xa9, 0x3a, 0xfd, 0x1e, 0x00, 0x65, 0x04,
+	0x73, 0x97, 0x92, 0x02, 0x00, 0x00,
+}
+
+func (this *MsgUpdateAllowedMsgTypes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateAllowedMsgTypes)
+	if !ok {
+		that2, ok := that.(MsgUpdateAllowedMsgTypes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateDeniedMsgTypes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateDeniedMsgTypes)
+	if !ok {
+		that2, ok := that.(MsgUpdateDeniedMsgTypes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateAllowedMsgTypes defines a method for replacing the allowlist of the message types
+	UpdateAllowedMsgTypes(ctx context.Context, in *MsgUpdateAllowedMsgTypes, opts ...grpc.CallOption) (*MsgUpdateAllowedMsgTypesResponse, error)
+	// UpdateDeniedMsgTypes defines a method for replacing the denylist of the message types
+	UpdateDeniedMsgTypes(ctx context.Context, in *MsgUpdateDeniedMsgTypes, opts ...grpc.CallOption) (*MsgUpdateDeniedMsgTypesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateAllowedMsgTypes(ctx context.Context, in *MsgUpdateAllowedMsgTypes, opts ...grpc.CallOption) (*MsgUpdateAllowedMsgTypesResponse, error) {
+	out := new(MsgUpdateAllowedMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/irita.msgfilter.Msg/UpdateAllowedMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDeniedMsgTypes(ctx context.Context, in *MsgUpdateDeniedMsgTypes, opts ...grpc.CallOption) (*MsgUpdateDeniedMsgTypesResponse, error) {
+	out := new(MsgUpdateDeniedMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/irita.msgfilter.Msg/UpdateDeniedMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateAllowedMsgTypes defines a method for replacing the allowlist of the message types
+	UpdateAllowedMsgTypes(context.Context, *MsgUpdateAllowedMsgTypes) (*MsgUpdateAllowedMsgTypesResponse, error)
+	// UpdateDeniedMsgTypes defines a method for replacing the denylist of the message types
+	UpdateDeniedMsgTypes(context.Context, *MsgUpdateDeniedMsgTypes) (*MsgUpdateDeniedMsgTypesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateAllowedMsgTypes(ctx context.Context, req *MsgUpdateAllowedMsgTypes) (*MsgUpdateAllowedMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowedMsgTypes not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDeniedMsgTypes(ctx context.Context, req *MsgUpdateDeniedMsgTypes) (*MsgUpdateDeniedMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeniedMsgTypes not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateAllowedMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowedMsgTypes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowedMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irita.msgfilter.Msg/UpdateAllowedMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowedMsgTypes(ctx, req.(*MsgUpdateAllowedMsgTypes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDeniedMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeniedMsgTypes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeniedMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irita.msgfilter.Msg/UpdateDeniedMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeniedMsgTypes(ctx, req.(*MsgUpdateDeniedMsgTypes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irita.msgfilter.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateAllowedMsgTypes",
+			Handler:    _Msg_UpdateAllowedMsgTypes_Handler,
+		},
+		{
+			MethodName: "UpdateDeniedMsgTypes",
+			Handler:    _Msg_UpdateDeniedMsgTypes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgfilter/tx.proto",
+}
+
+func (m *MsgUpdateAllowedMsgTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedMsgTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedMsgTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeniedMsgTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeniedMsgTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeniedMsgTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeniedMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeniedMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeniedMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateAllowedMsgTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowedMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDeniedMsgTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDeniedMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateAllowedMsgTypes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowedMsgTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowedMsgTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowedMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowedMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowedMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDeniedMsgTypes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeniedMsgTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeniedMsgTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDeniedMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeniedMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeniedMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
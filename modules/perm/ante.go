@@ -0,0 +1,82 @@
+package perm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/bianjieai/irita/modules/perm/keeper"
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// FreezeDecorator rejects the txs signed by frozen accounts, as well as the
+// ethereum txs sending to frozen accounts. The values transferred inside the
+// EVM and the recipients of the precompiled contracts are checked by the
+// precompile registry.
+// In the ethereum ante chain it must run after the signature verification,
+// which sets the sender of the ethereum txs.
+type FreezeDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewFreezeDecorator creates a new FreezeDecorator
+func NewFreezeDecorator(k keeper.Keeper) FreezeDecorator {
+	return FreezeDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (fd FreezeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			if err := fd.checkSigners(ctx, msg); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
+		sender := sdk.AccAddress(common.HexToAddress(msgEthTx.From).Bytes())
+		if fd.keeper.IsAccountFrozen(ctx, sender) {
+			return ctx, sdkerrors.Wrapf(types.ErrAccountFrozen, "signer %s", sender)
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid unpack transaction data")
+		}
+
+		if to := txData.GetTo(); to != nil && fd.keeper.IsAccountFrozen(ctx, to.Bytes()) {
+			return ctx, sdkerrors.Wrapf(types.ErrAccountFrozen, "recipient %s", sdk.AccAddress(to.Bytes()))
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkSigners checks the signers of the msg, including the granters of the msgs executed through authz
+func (fd FreezeDecorator) checkSigners(ctx sdk.Context, msg sdk.Msg) error {
+	for _, signer := range msg.GetSigners() {
+		if fd.keeper.IsAccountFrozen(ctx, signer) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "signer %s", signer)
+		}
+	}
+
+	msgExec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil
+	}
+
+	msgs, err := msgExec.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := fd.checkSigners(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
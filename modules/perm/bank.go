@@ -0,0 +1,36 @@
+package perm
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bianjieai/irita/modules/perm/keeper"
+)
+
+// BankAppModule wraps the bank AppModule so that the bank messages are
+// served with the freeze restrictions of the perm module
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper keeper.BankKeeper
+}
+
+// NewBankAppModule creates a new BankAppModule object
+func NewBankAppModule(cdc codec.Codec, bk keeper.BankKeeper, accountKeeper banktypes.AccountKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule: bank.NewAppModule(cdc, bk, accountKeeper),
+		keeper:    bk,
+	}
+}
+
+// RegisterServices registers module services.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// GetQueryCmd returns the query commands for the perm module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the perm module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryRoles(),
+		GetCmdQueryRoleAccounts(),
+		GetCmdQueryBlockedContracts(),
+		GetCmdQueryBlockedContract(),
+		GetCmdQueryFrozenAccounts(),
+		GetCmdQueryFrozenAccount(),
+		GetCmdQueryContractSponsors(),
+		GetCmdQueryContractSponsor(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryRoles implements the query roles command.
+func GetCmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "roles [address]",
+		Short:   "Query the roles of an account",
+		Example: "$ irita query perm roles <address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Roles(context.Background(), &types.QueryRolesRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRoleAccounts implements the query role accounts command.
+func GetCmdQueryRoleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "role-accounts [role]",
+		Short:   "Query the accounts which have been granted a role",
+		Example: "$ irita query perm role-accounts TIBC_ADMIN",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := types.RoleFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RoleAccounts(context.Background(), &types.QueryRoleAccountsRequest{Role: role})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlockedContracts implements the query blocked contracts command.
+func GetCmdQueryBlockedContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-contracts",
+		Short:   "Query all the blocked EVM contracts",
+		Example: "$ irita query perm blocked-contracts",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockedContracts(context.Background(), &types.QueryBlockedContractsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlockedContract implements the query blocked contract command.
+func GetCmdQueryBlockedContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-contract [contract-address]",
+		Short:   "Query whether an EVM contract is blocked",
+		Example: "$ irita query perm blocked-contract 0x<contract-address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateContractAddress(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockedContract(context.Background(), &types.QueryBlockedContractRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFrozenAccounts implements the query frozen accounts command.
+func GetCmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-accounts",
+		Short:   "Query all the frozen accounts along with the freeze reasons and times",
+		Example: "$ irita query perm frozen-accounts",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenAccounts(context.Background(), &types.QueryFrozenAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFrozenAccount implements the query frozen account command.
+func GetCmdQueryFrozenAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-account [address]",
+		Short:   "Query whether an account is frozen",
+		Example: "$ irita query perm frozen-account <address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := types.AccAddressFromString(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenAccount(context.Background(), &types.QueryFrozenAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractSponsors implements the query contract sponsors command.
+func GetCmdQueryContractSponsors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-sponsors",
+		Short:   "Query all the sponsors of the EVM contracts",
+		Example: "$ irita query perm contract-sponsors",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractSponsors(context.Background(), &types.QueryContractSponsorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractSponsor implements the query contract sponsor command.
+func GetCmdQueryContractSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-sponsor [contract-address]",
+		Short:   "Query the sponsor of an EVM contract",
+		Example: "$ irita query perm contract-sponsor 0x<contract-address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateContractAddress(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractSponsor(context.Background(), &types.QueryContractSponsorRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
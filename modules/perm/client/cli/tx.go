@@ -0,0 +1,339 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+const flagSpendLimit = "spend-limit"
+
+// NewTxCmd returns the transaction commands for the perm module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Perm transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewAssignRolesCmd(),
+		NewUnassignRolesCmd(),
+		NewBlockContractCmd(),
+		NewUnblockContractCmd(),
+		NewAddContractDeployerCmd(),
+		NewRemoveContractDeployerCmd(),
+		NewFreezeAccountCmd(),
+		NewUnfreezeAccountCmd(),
+		NewSetContractSponsorCmd(),
+		NewRemoveContractSponsorCmd(),
+	)
+
+	return txCmd
+}
+
+// NewAssignRolesCmd implements the assign roles command.
+func NewAssignRolesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "assign-roles [address] [roles]",
+		Short:   "Assign roles to an account",
+		Example: "$ irita tx perm assign-roles <address> TIBC_ADMIN,PERM_ADMIN --from=<key-name>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			roles, err := types.RolesFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAssignRoles(roles, args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnassignRolesCmd implements the unassign roles command.
+func NewUnassignRolesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unassign-roles [address] [roles]",
+		Short:   "Unassign roles from an account",
+		Example: "$ irita tx perm unassign-roles <address> TIBC_ADMIN --from=<key-name>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			roles, err := types.RolesFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnassignRoles(roles, args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBlockContractCmd implements the block contract command.
+func NewBlockContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "block-contract [contract-address]",
+		Short:   "Block the calls to an EVM contract",
+		Example: "$ irita tx perm block-contract 0x<contract-address> --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBlockContract(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnblockContractCmd implements the unblock contract command.
+func NewUnblockContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unblock-contract [contract-address]",
+		Short:   "Unblock the calls to an EVM contract",
+		Example: "$ irita tx perm unblock-contract 0x<contract-address> --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnblockContract(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAddContractDeployerCmd implements the add contract deployer command.
+func NewAddContractDeployerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-contract-deployer [address]",
+		Short:   "Add an account to the contract deployer allowlist",
+		Long:    "Add an account to the contract deployer allowlist. The address can be given either in bech32 or in hex.",
+		Example: "$ irita tx perm add-contract-deployer <address> --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddContractDeployer(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveContractDeployerCmd implements the remove contract deployer command.
+func NewRemoveContractDeployerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-contract-deployer [address]",
+		Short:   "Remove an account from the contract deployer allowlist",
+		Long:    "Remove an account from the contract deployer allowlist. The address can be given either in bech32 or in hex.",
+		Example: "$ irita tx perm remove-contract-deployer <address> --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveContractDeployer(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFreezeAccountCmd implements the freeze account command.
+func NewFreezeAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freeze-account [address] [reason]",
+		Short:   "Freeze an account so that it can neither sign transactions nor receive transfers",
+		Example: "$ irita tx perm freeze-account <address> \"compromised key\" --from=<key-name>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeAccount(args[0], args[1], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnfreezeAccountCmd implements the unfreeze account command.
+func NewUnfreezeAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unfreeze-account [address]",
+		Short:   "Unfreeze an account",
+		Example: "$ irita tx perm unfreeze-account <address> --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeAccount(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetContractSponsorCmd implements the set contract sponsor command.
+func NewSetContractSponsorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-contract-sponsor [contract-address]",
+		Short:   "Pay the fees of the ethereum txs calling an EVM contract, up to an optional spend limit",
+		Example: fmt.Sprintf("$ irita tx perm set-contract-sponsor 0x<contract-address> --%s=1000000uirita --from=<key-name>", flagSpendLimit),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, _ := cmd.Flags().GetString(flagSpendLimit)
+			spendLimit, err := sdk.ParseCoinsNormalized(limit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetContractSponsor(args[0], spendLimit, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Amount of fees to pay at most, unlimited if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveContractSponsorCmd implements the remove contract sponsor command.
+func NewRemoveContractSponsorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-contract-sponsor [contract-address]",
+		Short:   "Remove the sponsor of an EVM contract",
+		Example: "$ irita tx perm remove-contract-sponsor 0x<contract-address> --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveContractSponsor(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package perm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/perm/keeper"
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// InitGenesis stores the genesis role accounts, blocked contracts, frozen accounts
+// and contract sponsors
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	for _, ra := range data.RoleAccounts {
+		address, _ := types.AccAddressFromString(ra.Address)
+		for _, role := range ra.Roles {
+			k.SetRole(ctx, address, role)
+		}
+	}
+
+	for _, bc := range data.BlockedContracts {
+		bc.Address = common.HexToAddress(bc.Address).Hex()
+		k.SetBlockedContract(ctx, bc)
+	}
+
+	for _, fa := range data.FrozenAccounts {
+		k.SetFrozenAccount(ctx, fa)
+	}
+
+	for _, cs := range data.ContractSponsors {
+		cs.Contract = common.HexToAddress(cs.Contract).Hex()
+		k.SetContractSponsor(ctx, cs)
+	}
+}
+
+// ExportGenesis outputs the role accounts, blocked contracts, frozen accounts and
+// contract sponsors
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	seen := make(map[string]bool)
+	var addresses []sdk.AccAddress
+
+	for _, role := range types.AllRoles() {
+		k.IterateRoleAccounts(ctx, role, func(address sdk.AccAddress) bool {
+			if !seen[address.String()] {
+				seen[address.String()] = true
+				addresses = append(addresses, address)
+			}
+			return false
+		})
+	}
+
+	roleAccounts := make([]types.RoleAccount, 0, len(addresses))
+	for _, address := range addresses {
+		roleAccounts = append(roleAccounts, types.NewRoleAccount(address.String(), k.GetRoles(ctx, address)))
+	}
+	return types.NewGenesisState(roleAccounts, k.GetBlockedContracts(ctx), k.GetFrozenAccounts(ctx), k.GetContractSponsors(ctx))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+var _ bankkeeper.Keeper = BankKeeper{}
+
+// BankKeeper wraps the bank keeper to reject the transfers from or to frozen accounts.
+// The transfers between modules and accounts are not restricted.
+type BankKeeper struct {
+	bankkeeper.BaseKeeper
+	permKeeper Keeper
+}
+
+// WrapBankKeeper returns a bank keeper which applies the freeze restrictions
+func WrapBankKeeper(bk bankkeeper.BaseKeeper, pk Keeper) BankKeeper {
+	return BankKeeper{
+		BaseKeeper: bk,
+		permKeeper: pk,
+	}
+}
+
+// SendCoins transfers coins between accounts unless any of them is frozen
+func (k BankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkNotFrozen(ctx, fromAddr, toAddr); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins performs a multi-send unless any of the accounts involved is frozen
+func (k BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	addrs := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	if err := k.checkNotFrozen(ctx, addrs...); err != nil {
+		return err
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+func (k BankKeeper) checkNotFrozen(ctx sdk.Context, addrs ...sdk.AccAddress) error {
+	for _, addr := range addrs {
+		if k.permKeeper.IsAccountFrozen(ctx, addr) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s", addr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// BlockContract blocks the calls to the given contract on behalf of the operator
+func (k Keeper) BlockContract(ctx sdk.Context, contract common.Address, operator sdk.AccAddress) error {
+	if !k.IsAuthorized(ctx, operator, types.RoleContractAdmin) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a contract admin", operator)
+	}
+	if k.IsContractBlocked(ctx, contract) {
+		return sdkerrors.Wrapf(types.ErrContractBlocked, "%s", contract)
+	}
+
+	k.SetBlockedContract(ctx, types.NewBlockedContract(contract.Hex(), operator.String(), ctx.BlockHeight()))
+	return nil
+}
+
+// UnblockContract unblocks the calls to the given contract on behalf of the operator
+func (k Keeper) UnblockContract(ctx sdk.Context, contract common.Address, operator sdk.AccAddress) error {
+	if !k.IsAuthorized(ctx, operator, types.RoleContractAdmin) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a contract admin", operator)
+	}
+	if !k.IsContractBlocked(ctx, contract) {
+		return sdkerrors.Wrapf(types.ErrContractNotBlocked, "%s", contract)
+	}
+
+	k.DeleteBlockedContract(ctx, contract)
+	return nil
+}
+
+// GetBlockContract returns true if the contract with the given address is blocked.
+// It implements the evm ContractCallable interface.
+func (k Keeper) GetBlockContract(ctx sdk.Context, address []byte) bool {
+	return k.IsContractBlocked(ctx, common.BytesToAddress(address))
+}
+
+// IsContractBlocked returns true if the contract is blocked
+func (k Keeper) IsContractBlocked(ctx sdk.Context, contract common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetBlockedContractKey(contract))
+}
+
+// GetBlockedContract retrieves the blocked contract with the given address
+func (k Keeper) GetBlockedContract(ctx sdk.Context, contract common.Address) (types.BlockedContract, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBlockedContractKey(contract))
+	if bz == nil {
+		return types.BlockedContract{}, false
+	}
+
+	var blocked types.BlockedContract
+	k.cdc.MustUnmarshal(bz, &blocked)
+	return blocked, true
+}
+
+// SetBlockedContract stores the blocked contract
+func (k Keeper) SetBlockedContract(ctx sdk.Context, blocked types.BlockedContract) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.GetBlockedContractKey(common.HexToAddress(blocked.Address)),
+		k.cdc.MustMarshal(&blocked),
+	)
+}
+
+// DeleteBlockedContract removes the contract from the blocked contracts
+func (k Keeper) DeleteBlockedContract(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBlockedContractKey(contract))
+}
+
+// GetBlockedContracts returns all the blocked contracts
+func (k Keeper) GetBlockedContracts(ctx sdk.Context) []types.BlockedContract {
+	contracts := make([]types.BlockedContract, 0)
+	k.IterateBlockedContracts(ctx, func(blocked types.BlockedContract) bool {
+		contracts = append(contracts, blocked)
+		return false
+	})
+	return contracts
+}
+
+// IterateBlockedContracts iterates through all the blocked contracts
+func (k Keeper) IterateBlockedContracts(ctx sdk.Context, op func(blocked types.BlockedContract) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockedContractKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var blocked types.BlockedContract
+		k.cdc.MustUnmarshal(iterator.Value(), &blocked)
+
+		if stop := op(blocked); stop {
+			break
+		}
+	}
+}
+
+// AddContractDeployer adds the account to the contract deployer allowlist on behalf of the operator
+func (k Keeper) AddContractDeployer(ctx sdk.Context, address, operator sdk.AccAddress) error {
+	return k.AssignRoles(ctx, address, operator, []types.Role{types.RoleContractDeployer})
+}
+
+// RemoveContractDeployer removes the account from the contract deployer allowlist on behalf of the operator
+func (k Keeper) RemoveContractDeployer(ctx sdk.Context, address, operator sdk.AccAddress) error {
+	return k.UnassignRoles(ctx, address, operator, []types.Role{types.RoleContractDeployer})
+}
+
+// CanDeployContract returns true if the account with the given address is allowed to deploy contracts.
+// It implements the evm ContractDeployable interface.
+func (k Keeper) CanDeployContract(ctx sdk.Context, address []byte) bool {
+	return k.IsAuthorized(ctx, address, types.RoleContractDeployer)
+}
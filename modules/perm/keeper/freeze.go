@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// FreezeAccount freezes the account on behalf of the operator
+func (k Keeper) FreezeAccount(ctx sdk.Context, address, operator sdk.AccAddress, reason string) error {
+	if !k.IsAuthorized(ctx, operator, types.RoleComplianceAdmin) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a compliance admin", operator)
+	}
+	if address.Equals(operator) {
+		return types.ErrFreezeSelf
+	}
+	if k.IsAccountFrozen(ctx, address) {
+		return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s", address)
+	}
+
+	k.SetFrozenAccount(ctx, types.NewFrozenAccount(
+		address.String(), reason, operator.String(), ctx.BlockTime(), ctx.BlockHeight(),
+	))
+	return nil
+}
+
+// UnfreezeAccount unfreezes the account on behalf of the operator
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, address, operator sdk.AccAddress) error {
+	if !k.IsAuthorized(ctx, operator, types.RoleComplianceAdmin) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a compliance admin", operator)
+	}
+	if !k.IsAccountFrozen(ctx, address) {
+		return sdkerrors.Wrapf(types.ErrAccountNotFrozen, "%s", address)
+	}
+
+	k.DeleteFrozenAccount(ctx, address)
+	return nil
+}
+
+// IsAccountFrozen returns true if the account is frozen
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFrozenAccountKey(address))
+}
+
+// GetFrozenAccount retrieves the frozen account with the given address
+func (k Keeper) GetFrozenAccount(ctx sdk.Context, address sdk.AccAddress) (types.FrozenAccount, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFrozenAccountKey(address))
+	if bz == nil {
+		return types.FrozenAccount{}, false
+	}
+
+	var frozen types.FrozenAccount
+	k.cdc.MustUnmarshal(bz, &frozen)
+	return frozen, true
+}
+
+// SetFrozenAccount stores the frozen account
+func (k Keeper) SetFrozenAccount(ctx sdk.Context, frozen types.FrozenAccount) {
+	address, _ := types.AccAddressFromString(frozen.Address)
+	frozen.Address = address.String()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFrozenAccountKey(address), k.cdc.MustMarshal(&frozen))
+}
+
+// DeleteFrozenAccount removes the account from the frozen accounts
+func (k Keeper) DeleteFrozenAccount(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFrozenAccountKey(address))
+}
+
+// GetFrozenAccounts returns all the frozen accounts
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context) []types.FrozenAccount {
+	accounts := make([]types.FrozenAccount, 0)
+	k.IterateFrozenAccounts(ctx, func(frozen types.FrozenAccount) bool {
+		accounts = append(accounts, frozen)
+		return false
+	})
+	return accounts
+}
+
+// IterateFrozenAccounts iterates through all the frozen accounts
+func (k Keeper) IterateFrozenAccounts(ctx sdk.Context, op func(frozen types.FrozenAccount) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FrozenAccountKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var frozen types.FrozenAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &frozen)
+
+		if stop := op(frozen); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Roles queries the roles of the given account
+func (k Keeper) Roles(c context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryRolesResponse{Roles: k.GetRoles(ctx, address)}, nil
+}
+
+// RoleAccounts queries the accounts which have been granted the given role
+func (k Keeper) RoleAccounts(c context.Context, req *types.QueryRoleAccountsRequest) (*types.QueryRoleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if !req.Role.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %d", req.Role)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addrs := k.GetRoleAccounts(ctx, req.Role)
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+	return &types.QueryRoleAccountsResponse{Addresses: addresses}, nil
+}
+
+// BlockedContracts queries all the blocked EVM contracts
+func (k Keeper) BlockedContracts(c context.Context, req *types.QueryBlockedContractsRequest) (*types.QueryBlockedContractsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBlockedContractsResponse{Contracts: k.GetBlockedContracts(ctx)}, nil
+}
+
+// BlockedContract queries whether the given EVM contract is blocked
+func (k Keeper) BlockedContract(c context.Context, req *types.QueryBlockedContractRequest) (*types.QueryBlockedContractResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateContractAddress(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blocked, found := k.GetBlockedContract(ctx, common.HexToAddress(req.Address))
+	if !found {
+		return &types.QueryBlockedContractResponse{Blocked: false}, nil
+	}
+	return &types.QueryBlockedContractResponse{Blocked: true, Contract: &blocked}, nil
+}
+
+// FrozenAccounts queries all the frozen accounts along with the freeze reasons and times
+func (k Keeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFrozenAccountsResponse{Accounts: k.GetFrozenAccounts(ctx)}, nil
+}
+
+// FrozenAccount queries whether the given account is frozen
+func (k Keeper) FrozenAccount(c context.Context, req *types.QueryFrozenAccountRequest) (*types.QueryFrozenAccountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := types.AccAddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	frozen, found := k.GetFrozenAccount(ctx, address)
+	if !found {
+		return &types.QueryFrozenAccountResponse{Frozen: false}, nil
+	}
+	return &types.QueryFrozenAccountResponse{Frozen: true, Account: &frozen}, nil
+}
+
+// ContractSponsors queries all the sponsors of the EVM contracts
+func (k Keeper) ContractSponsors(c context.Context, req *types.QueryContractSponsorsRequest) (*types.QueryContractSponsorsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryContractSponsorsResponse{Sponsors: k.GetContractSponsors(ctx)}, nil
+}
+
+// ContractSponsor queries the sponsor of the given EVM contract
+func (k Keeper) ContractSponsor(c context.Context, req *types.QueryContractSponsorRequest) (*types.QueryContractSponsorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateContractAddress(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsor, found := k.GetContractSponsor(ctx, common.HexToAddress(req.Address))
+	if !found {
+		return &types.QueryContractSponsorResponse{Sponsored: false}, nil
+	}
+	return &types.QueryContractSponsorResponse{Sponsored: true, Sponsor: &sponsor}, nil
+}
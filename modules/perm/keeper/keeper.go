@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// Keeper defines the perm keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Codec
+	// authority is authorized as a root admin without holding the role, e.g. the
+	// proposal module account executing the approved messages
+	authority sdk.AccAddress
+}
+
+// NewKeeper creates a new perm Keeper instance. The authority may be nil.
+func NewKeeper(cdc codec.Codec, key sdk.StoreKey, authority sdk.AccAddress) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// AssignRoles grants the given roles to the account on behalf of the operator
+func (k Keeper) AssignRoles(ctx sdk.Context, address, operator sdk.AccAddress, roles []types.Role) error {
+	for _, role := range roles {
+		if !k.CanManage(ctx, operator, role) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not allowed to assign the role %s", operator, role)
+		}
+	}
+
+	for _, role := range roles {
+		k.SetRole(ctx, address, role)
+	}
+	return nil
+}
+
+// UnassignRoles revokes the given roles from the account on behalf of the operator
+func (k Keeper) UnassignRoles(ctx sdk.Context, address, operator sdk.AccAddress, roles []types.Role) error {
+	for _, role := range roles {
+		if !k.CanManage(ctx, operator, role) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not allowed to unassign the role %s", operator, role)
+		}
+		if !k.HasRole(ctx, address, role) {
+			return sdkerrors.Wrapf(types.ErrRoleNotAssigned, "%s does not have the role %s", address, role)
+		}
+		if role == types.RoleRootAdmin && len(k.GetRoleAccounts(ctx, types.RoleRootAdmin)) == 1 {
+			return types.ErrLastRootAdmin
+		}
+	}
+
+	for _, role := range roles {
+		k.DeleteRole(ctx, address, role)
+	}
+	return nil
+}
+
+// CanManage returns true if the operator is allowed to assign and unassign the role
+func (k Keeper) CanManage(ctx sdk.Context, operator sdk.AccAddress, role types.Role) bool {
+	return k.IsAuthorized(ctx, operator, role.ManagerRoles()...)
+}
+
+// IsAuthorized returns true if the account is the authority, a root admin or has any
+// of the given roles
+func (k Keeper) IsAuthorized(ctx sdk.Context, address sdk.AccAddress, roles ...types.Role) bool {
+	if !k.authority.Empty() && k.authority.Equals(address) {
+		return true
+	}
+	if k.HasRole(ctx, address, types.RoleRootAdmin) {
+		return true
+	}
+
+	for _, role := range roles {
+		if k.HasRole(ctx, address, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole returns true if the account has the given role
+func (k Keeper) HasRole(ctx sdk.Context, address sdk.AccAddress, role types.Role) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRoleAccountKey(role, address))
+}
+
+// SetRole grants the role to the account
+func (k Keeper) SetRole(ctx sdk.Context, address sdk.AccAddress, role types.Role) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRoleAccountKey(role, address), []byte{})
+}
+
+// DeleteRole revokes the role from the account
+func (k Keeper) DeleteRole(ctx sdk.Context, address sdk.AccAddress, role types.Role) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRoleAccountKey(role, address))
+}
+
+// GetRoles returns all the roles of the account
+func (k Keeper) GetRoles(ctx sdk.Context, address sdk.AccAddress) []types.Role {
+	roles := make([]types.Role, 0)
+	for _, role := range types.AllRoles() {
+		if k.HasRole(ctx, address, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// GetRoleAccounts returns all the accounts which have been granted the role
+func (k Keeper) GetRoleAccounts(ctx sdk.Context, role types.Role) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, 0)
+	k.IterateRoleAccounts(ctx, role, func(address sdk.AccAddress) bool {
+		addrs = append(addrs, address)
+		return false
+	})
+	return addrs
+}
+
+// IterateRoleAccounts iterates through all the accounts of the role
+func (k Keeper) IterateRoleAccounts(ctx sdk.Context, role types.Role, op func(address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRoleAccountSubspace(role))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if stop := op(types.AddressFromRoleAccountKey(iterator.Key())); stop {
+			break
+		}
+	}
+}
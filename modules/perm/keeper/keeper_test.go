@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/perm/keeper"
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+var (
+	rootAdmin = sdk.AccAddress(tmhash.SumTruncated([]byte("rootAdmin")))
+	permAdmin = sdk.AccAddress(tmhash.SumTruncated([]byte("permAdmin")))
+	tibcAdmin = sdk.AccAddress(tmhash.SumTruncated([]byte("tibcAdmin")))
+	user      = sdk.AccAddress(tmhash.SumTruncated([]byte("user")))
+	authority = sdk.AccAddress(tmhash.SumTruncated([]byte("authority")))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey("transient_test")
+
+	suite.ctx = testutil.DefaultContext(key, tkey)
+	suite.keeper = keeper.NewKeeper(simappparams.MakeTestEncodingConfig().Marshaler, key, authority)
+
+	suite.keeper.SetRole(suite.ctx, rootAdmin, types.RoleRootAdmin)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestAssignRoles() {
+	err := suite.keeper.AssignRoles(suite.ctx, permAdmin, rootAdmin, []types.Role{types.RolePermAdmin})
+	suite.NoError(err)
+
+	// a perm admin cannot create another perm admin
+	err = suite.keeper.AssignRoles(suite.ctx, user, permAdmin, []types.Role{types.RolePermAdmin})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.AssignRoles(suite.ctx, tibcAdmin, permAdmin, []types.Role{types.RoleTIBCAdmin})
+	suite.NoError(err)
+
+	// a TIBC admin manages the TIBC admin set
+	err = suite.keeper.AssignRoles(suite.ctx, user, tibcAdmin, []types.Role{types.RoleTIBCAdmin})
+	suite.NoError(err)
+
+	err = suite.keeper.AssignRoles(suite.ctx, user, tibcAdmin, []types.Role{types.RolePermAdmin})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Equal([]types.Role{types.RoleTIBCAdmin}, suite.keeper.GetRoles(suite.ctx, user))
+	suite.Len(suite.keeper.GetRoleAccounts(suite.ctx, types.RoleTIBCAdmin), 2)
+
+	suite.True(suite.keeper.IsAuthorized(suite.ctx, user, types.RoleTIBCAdmin))
+	suite.True(suite.keeper.IsAuthorized(suite.ctx, rootAdmin, types.RoleTIBCAdmin))
+	suite.False(suite.keeper.IsAuthorized(suite.ctx, permAdmin, types.RoleTIBCAdmin))
+}
+
+func (suite *KeeperTestSuite) TestAuthority() {
+	// the authority is authorized as a root admin without holding any role
+	suite.True(suite.keeper.IsAuthorized(suite.ctx, authority, types.RoleTIBCAdmin))
+	suite.Empty(suite.keeper.GetRoles(suite.ctx, authority))
+
+	err := suite.keeper.AssignRoles(suite.ctx, user, authority, []types.Role{types.RoleRootAdmin})
+	suite.NoError(err)
+	suite.True(suite.keeper.HasRole(suite.ctx, user, types.RoleRootAdmin))
+}
+
+func (suite *KeeperTestSuite) TestUnassignRoles() {
+	suite.keeper.SetRole(suite.ctx, tibcAdmin, types.RoleTIBCAdmin)
+
+	err := suite.keeper.UnassignRoles(suite.ctx, tibcAdmin, user, []types.Role{types.RoleTIBCAdmin})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.UnassignRoles(suite.ctx, tibcAdmin, tibcAdmin, []types.Role{types.RoleTIBCAdmin})
+	suite.NoError(err)
+	suite.False(suite.keeper.HasRole(suite.ctx, tibcAdmin, types.RoleTIBCAdmin))
+
+	err = suite.keeper.UnassignRoles(suite.ctx, tibcAdmin, rootAdmin, []types.Role{types.RoleTIBCAdmin})
+	suite.ErrorIs(err, types.ErrRoleNotAssigned)
+
+	err = suite.keeper.UnassignRoles(suite.ctx, rootAdmin, rootAdmin, []types.Role{types.RoleRootAdmin})
+	suite.ErrorIs(err, types.ErrLastRootAdmin)
+}
+
+func (suite *KeeperTestSuite) TestBlockContract() {
+	contract := common.BytesToAddress([]byte("contract"))
+
+	err := suite.keeper.BlockContract(suite.ctx, contract, user)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	suite.keeper.SetRole(suite.ctx, user, types.RoleContractAdmin)
+
+	err = suite.keeper.BlockContract(suite.ctx, contract, user)
+	suite.NoError(err)
+	suite.True(suite.keeper.GetBlockContract(suite.ctx, contract.Bytes()))
+
+	err = suite.keeper.BlockContract(suite.ctx, contract, rootAdmin)
+	suite.ErrorIs(err, types.ErrContractBlocked)
+
+	blocked, found := suite.keeper.GetBlockedContract(suite.ctx, contract)
+	suite.True(found)
+	suite.Equal(types.NewBlockedContract(contract.Hex(), user.String(), suite.ctx.BlockHeight()), blocked)
+	suite.Equal([]types.BlockedContract{blocked}, suite.keeper.GetBlockedContracts(suite.ctx))
+
+	err = suite.keeper.UnblockContract(suite.ctx, contract, rootAdmin)
+	suite.NoError(err)
+	suite.False(suite.keeper.GetBlockContract(suite.ctx, contract.Bytes()))
+
+	err = suite.keeper.UnblockContract(suite.ctx, contract, user)
+	suite.ErrorIs(err, types.ErrContractNotBlocked)
+}
+
+func (suite *KeeperTestSuite) TestContractDeployer() {
+	suite.True(suite.keeper.CanDeployContract(suite.ctx, rootAdmin))
+	suite.False(suite.keeper.CanDeployContract(suite.ctx, user))
+
+	err := suite.keeper.AddContractDeployer(suite.ctx, user, tibcAdmin)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	suite.keeper.SetRole(suite.ctx, permAdmin, types.RoleContractAdmin)
+
+	// the deployer can be given in hex
+	deployer, err := types.AccAddressFromString(common.BytesToAddress(user).Hex())
+	suite.NoError(err)
+	suite.Equal(user, deployer)
+
+	err = suite.keeper.AddContractDeployer(suite.ctx, deployer, permAdmin)
+	suite.NoError(err)
+	suite.True(suite.keeper.CanDeployContract(suite.ctx, user))
+
+	err = suite.keeper.RemoveContractDeployer(suite.ctx, user, permAdmin)
+	suite.NoError(err)
+	suite.False(suite.keeper.CanDeployContract(suite.ctx, user))
+}
+
+func (suite *KeeperTestSuite) TestFreezeAccount() {
+	err := suite.keeper.FreezeAccount(suite.ctx, user, permAdmin, "compromised")
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.FreezeAccount(suite.ctx, rootAdmin, rootAdmin, "compromised")
+	suite.ErrorIs(err, types.ErrFreezeSelf)
+
+	err = suite.keeper.FreezeAccount(suite.ctx, user, rootAdmin, "compromised")
+	suite.NoError(err)
+	suite.True(suite.keeper.IsAccountFrozen(suite.ctx, user))
+
+	err = suite.keeper.FreezeAccount(suite.ctx, user, rootAdmin, "compromised")
+	suite.ErrorIs(err, types.ErrAccountFrozen)
+
+	frozen, found := suite.keeper.GetFrozenAccount(suite.ctx, user)
+	suite.True(found)
+	suite.Equal(
+		types.NewFrozenAccount(user.String(), "compromised", rootAdmin.String(), suite.ctx.BlockTime(), suite.ctx.BlockHeight()),
+		frozen,
+	)
+	suite.Equal([]types.FrozenAccount{frozen}, suite.keeper.GetFrozenAccounts(suite.ctx))
+
+	err = suite.keeper.UnfreezeAccount(suite.ctx, user, rootAdmin)
+	suite.NoError(err)
+	suite.False(suite.keeper.IsAccountFrozen(suite.ctx, user))
+
+	err = suite.keeper.UnfreezeAccount(suite.ctx, user, rootAdmin)
+	suite.ErrorIs(err, types.ErrAccountNotFrozen)
+}
+
+func (suite *KeeperTestSuite) TestContractSponsor() {
+	contract := common.BytesToAddress([]byte("contract"))
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("uirita", 100))
+
+	err := suite.keeper.SponsorContract(suite.ctx, contract, user, spendLimit)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	suite.keeper.SetRole(suite.ctx, user, types.RoleContractDeployer)
+
+	err = suite.keeper.SponsorContract(suite.ctx, contract, user, spendLimit)
+	suite.NoError(err)
+
+	// another sponsor cannot take over the contract
+	err = suite.keeper.SponsorContract(suite.ctx, contract, rootAdmin, nil)
+	suite.ErrorIs(err, types.ErrContractSponsored)
+
+	sponsor, found := suite.keeper.GetContractSponsorAddress(suite.ctx, contract.Bytes())
+	suite.True(found)
+	suite.Equal(user, sponsor)
+
+	err = suite.keeper.UseContractSponsorFees(suite.ctx, contract.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("uirita", 60)))
+	suite.NoError(err)
+	cs, _ := suite.keeper.GetContractSponsor(suite.ctx, contract)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("uirita", 40)), cs.SpendLimit)
+
+	err = suite.keeper.UseContractSponsorFees(suite.ctx, contract.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("uirita", 60)))
+	suite.ErrorIs(err, types.ErrSpendLimitExceeded)
+
+	// the sponsor is removed once the spend limit is exhausted
+	err = suite.keeper.UseContractSponsorFees(suite.ctx, contract.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("uirita", 40)))
+	suite.NoError(err)
+	_, found = suite.keeper.GetContractSponsorAddress(suite.ctx, contract.Bytes())
+	suite.False(found)
+
+	// an unlimited sponsor
+	err = suite.keeper.SponsorContract(suite.ctx, contract, user, nil)
+	suite.NoError(err)
+	err = suite.keeper.UseContractSponsorFees(suite.ctx, contract.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("uirita", 1000)))
+	suite.NoError(err)
+	suite.Len(suite.keeper.GetContractSponsors(suite.ctx), 1)
+
+	err = suite.keeper.RemoveContractSponsor(suite.ctx, contract, permAdmin)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.RemoveContractSponsor(suite.ctx, contract, user)
+	suite.NoError(err)
+
+	err = suite.keeper.RemoveContractSponsor(suite.ctx, contract, rootAdmin)
+	suite.ErrorIs(err, types.ErrContractNotSponsored)
+}
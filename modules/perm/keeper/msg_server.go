@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the perm MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) AssignRoles(goCtx context.Context, msg *types.MsgAssignRoles) (*types.MsgAssignRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.AssignRoles(ctx, address, operator, msg.Roles); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAssignRoles,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, types.RolesString(msg.Roles)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgAssignRolesResponse{}, nil
+}
+
+func (m msgServer) UnassignRoles(goCtx context.Context, msg *types.MsgUnassignRoles) (*types.MsgUnassignRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UnassignRoles(ctx, address, operator, msg.Roles); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnassignRoles,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, types.RolesString(msg.Roles)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUnassignRolesResponse{}, nil
+}
+
+func (m msgServer) BlockContract(goCtx context.Context, msg *types.MsgBlockContract) (*types.MsgBlockContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateContractAddress(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if err := m.Keeper.BlockContract(ctx, contract, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBlockContract,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgBlockContractResponse{}, nil
+}
+
+func (m msgServer) UnblockContract(goCtx context.Context, msg *types.MsgUnblockContract) (*types.MsgUnblockContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateContractAddress(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if err := m.Keeper.UnblockContract(ctx, contract, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnblockContract,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUnblockContractResponse{}, nil
+}
+
+func (m msgServer) AddContractDeployer(goCtx context.Context, msg *types.MsgAddContractDeployer) (*types.MsgAddContractDeployerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := types.AccAddressFromString(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.AddContractDeployer(ctx, address, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddContractDeployer,
+			sdk.NewAttribute(types.AttributeKeyAccount, address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgAddContractDeployerResponse{}, nil
+}
+
+func (m msgServer) RemoveContractDeployer(goCtx context.Context, msg *types.MsgRemoveContractDeployer) (*types.MsgRemoveContractDeployerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := types.AccAddressFromString(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RemoveContractDeployer(ctx, address, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveContractDeployer,
+			sdk.NewAttribute(types.AttributeKeyAccount, address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRemoveContractDeployerResponse{}, nil
+}
+
+func (m msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := types.AccAddressFromString(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.FreezeAccount(ctx, address, operator, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, address.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (m msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := types.AccAddressFromString(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UnfreezeAccount(ctx, address, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+func (m msgServer) SetContractSponsor(goCtx context.Context, msg *types.MsgSetContractSponsor) (*types.MsgSetContractSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor, err := sdk.AccAddressFromBech32(msg.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateContractAddress(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if err := m.Keeper.SponsorContract(ctx, contract, sponsor, msg.SpendLimit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetContractSponsor,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, msg.SpendLimit.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sponsor),
+		),
+	})
+
+	return &types.MsgSetContractSponsorResponse{}, nil
+}
+
+func (m msgServer) RemoveContractSponsor(goCtx context.Context, msg *types.MsgRemoveContractSponsor) (*types.MsgRemoveContractSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateContractAddress(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if err := m.Keeper.RemoveContractSponsor(ctx, contract, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveContractSponsor,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRemoveContractSponsorResponse{}, nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bianjieai/irita/modules/perm/types"
+)
+
+// SponsorContract makes the sponsor pay the fees of the calls to the given contract, up to
+// the spend limit if any. The sponsor must be allowed to deploy contracts or be a contract admin,
+// and may only update the spend limit of a contract it already sponsors.
+func (k Keeper) SponsorContract(ctx sdk.Context, contract common.Address, sponsor sdk.AccAddress, spendLimit sdk.Coins) error {
+	if !k.IsAuthorized(ctx, sponsor, types.RoleContractDeployer, types.RoleContractAdmin) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is neither a contract deployer nor a contract admin", sponsor)
+	}
+	if cs, ok := k.GetContractSponsor(ctx, contract); ok && cs.Sponsor != sponsor.String() {
+		return sdkerrors.Wrapf(types.ErrContractSponsored, "%s is sponsored by %s", contract, cs.Sponsor)
+	}
+
+	k.SetContractSponsor(ctx, types.NewContractSponsor(contract.Hex(), sponsor.String(), spendLimit))
+	return nil
+}
+
+// RemoveContractSponsor removes the sponsor of the given contract on behalf of the operator,
+// which must be the sponsor itself or a contract admin
+func (k Keeper) RemoveContractSponsor(ctx sdk.Context, contract common.Address, operator sdk.AccAddress) error {
+	cs, ok := k.GetContractSponsor(ctx, contract)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrContractNotSponsored, "%s", contract)
+	}
+	if cs.Sponsor != operator.String() && !k.IsAuthorized(ctx, operator, types.RoleContractAdmin) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is neither the sponsor nor a contract admin", operator)
+	}
+
+	k.DeleteContractSponsor(ctx, contract)
+	return nil
+}
+
+// GetContractSponsorAddress returns the address of the sponsor of the contract with the given
+// address, if any. It implements the evm ContractSponsorable interface.
+func (k Keeper) GetContractSponsorAddress(ctx sdk.Context, address []byte) (sdk.AccAddress, bool) {
+	cs, ok := k.GetContractSponsor(ctx, common.BytesToAddress(address))
+	if !ok {
+		return nil, false
+	}
+	sponsor, _ := sdk.AccAddressFromBech32(cs.Sponsor)
+	return sponsor, true
+}
+
+// UseContractSponsorFees deducts the fee from the spend limit of the sponsor of the contract
+// with the given address, removing the sponsor once the spend limit is exhausted.
+// It implements the evm ContractSponsorable interface.
+func (k Keeper) UseContractSponsorFees(ctx sdk.Context, address []byte, fee sdk.Coins) error {
+	contract := common.BytesToAddress(address)
+	cs, ok := k.GetContractSponsor(ctx, contract)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrContractNotSponsored, "%s", contract)
+	}
+	if cs.SpendLimit.Empty() {
+		return nil
+	}
+
+	left, isNeg := cs.SpendLimit.SafeSub(fee)
+	if isNeg {
+		return sdkerrors.Wrapf(types.ErrSpendLimitExceeded, "%s left to pay the fee %s", cs.SpendLimit, fee)
+	}
+	if left.IsZero() {
+		k.DeleteContractSponsor(ctx, contract)
+		return nil
+	}
+
+	cs.SpendLimit = left
+	k.SetContractSponsor(ctx, cs)
+	return nil
+}
+
+// GetContractSponsor retrieves the sponsor of the contract with the given address
+func (k Keeper) GetContractSponsor(ctx sdk.Context, contract common.Address) (types.ContractSponsor, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetContractSponsorKey(contract))
+	if bz == nil {
+		return types.ContractSponsor{}, false
+	}
+
+	var cs types.ContractSponsor
+	k.cdc.MustUnmarshal(bz, &cs)
+	return cs, true
+}
+
+// SetContractSponsor stores the contract sponsor
+func (k Keeper) SetContractSponsor(ctx sdk.Context, cs types.ContractSponsor) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.GetContractSponsorKey(common.HexToAddress(cs.Contract)),
+		k.cdc.MustMarshal(&cs),
+	)
+}
+
+// DeleteContractSponsor removes the sponsor of the contract
+func (k Keeper) DeleteContractSponsor(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContractSponsorKey(contract))
+}
+
+// GetContractSponsors returns all the contract sponsors
+func (k Keeper) GetContractSponsors(ctx sdk.Context) []types.ContractSponsor {
+	sponsors := make([]types.ContractSponsor, 0)
+	k.IterateContractSponsors(ctx, func(cs types.ContractSponsor) bool {
+		sponsors = append(sponsors, cs)
+		return false
+	})
+	return sponsors
+}
+
+// IterateContractSponsors iterates through all the contract sponsors
+func (k Keeper) IterateContractSponsors(ctx sdk.Context, op func(cs types.ContractSponsor) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ContractSponsorKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var cs types.ContractSponsor
+		k.cdc.MustUnmarshal(iterator.Value(), &cs)
+
+		if stop := op(cs); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAssignRoles{}, "irita/perm/MsgAssignRoles", nil)
+	cdc.RegisterConcrete(&MsgUnassignRoles{}, "irita/perm/MsgUnassignRoles", nil)
+	cdc.RegisterConcrete(&MsgBlockContract{}, "irita/perm/MsgBlockContract", nil)
+	cdc.RegisterConcrete(&MsgUnblockContract{}, "irita/perm/MsgUnblockContract", nil)
+	cdc.RegisterConcrete(&MsgAddContractDeployer{}, "irita/perm/MsgAddContractDeployer", nil)
+	cdc.RegisterConcrete(&MsgRemoveContractDeployer{}, "irita/perm/MsgRemoveContractDeployer", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "irita/perm/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "irita/perm/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgSetContractSponsor{}, "irita/perm/MsgSetContractSponsor", nil)
+	cdc.RegisterConcrete(&MsgRemoveContractSponsor{}, "irita/perm/MsgRemoveContractSponsor", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAssignRoles{},
+		&MsgUnassignRoles{},
+		&MsgBlockContract{},
+		&MsgUnblockContract{},
+		&MsgAddContractDeployer{},
+		&MsgRemoveContractDeployer{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgSetContractSponsor{},
+		&MsgRemoveContractSponsor{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
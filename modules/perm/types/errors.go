@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// perm module sentinel errors
+var (
+	ErrInvalidRole     = sdkerrors.Register(ModuleName, 2, "invalid role")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 3, "unauthorized operation")
+	ErrRoleNotAssigned = sdkerrors.Register(ModuleName, 4, "role not assigned")
+	ErrLastRootAdmin   = sdkerrors.Register(ModuleName, 5, "cannot remove the last root admin")
+
+	ErrInvalidContractAddress = sdkerrors.Register(ModuleName, 6, "invalid contract address")
+	ErrContractBlocked        = sdkerrors.Register(ModuleName, 7, "contract is blocked")
+	ErrContractNotBlocked     = sdkerrors.Register(ModuleName, 8, "contract is not blocked")
+
+	ErrInvalidReason    = sdkerrors.Register(ModuleName, 9, "invalid freeze reason")
+	ErrAccountFrozen    = sdkerrors.Register(ModuleName, 10, "account is frozen")
+	ErrAccountNotFrozen = sdkerrors.Register(ModuleName, 11, "account is not frozen")
+	ErrFreezeSelf       = sdkerrors.Register(ModuleName, 12, "cannot freeze the operator itself")
+
+	ErrContractSponsored    = sdkerrors.Register(ModuleName, 13, "contract is already sponsored")
+	ErrContractNotSponsored = sdkerrors.Register(ModuleName, 14, "contract is not sponsored")
+	ErrSpendLimitExceeded   = sdkerrors.Register(ModuleName, 15, "sponsor spend limit exceeded")
+	ErrInvalidSpendLimit    = sdkerrors.Register(ModuleName, 16, "invalid sponsor spend limit")
+)
@@ -0,0 +1,26 @@
+package types
+
+// perm module event types
+const (
+	EventTypeAssignRoles     = "assign_roles"
+	EventTypeUnassignRoles   = "unassign_roles"
+	EventTypeBlockContract   = "block_contract"
+	EventTypeUnblockContract = "unblock_contract"
+
+	EventTypeAddContractDeployer    = "add_contract_deployer"
+	EventTypeRemoveContractDeployer = "remove_contract_deployer"
+
+	EventTypeFreezeAccount   = "freeze_account"
+	EventTypeUnfreezeAccount = "unfreeze_account"
+
+	EventTypeSetContractSponsor    = "set_contract_sponsor"
+	EventTypeRemoveContractSponsor = "remove_contract_sponsor"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyAccount    = "account"
+	AttributeKeyRole       = "role"
+	AttributeKeyContract   = "contract"
+	AttributeKeyReason     = "reason"
+	AttributeKeySponsor    = "sponsor"
+	AttributeKeySpendLimit = "spend_limit"
+)
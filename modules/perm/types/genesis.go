@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState constructs a new GenesisState instance
+func NewGenesisState(
+	roleAccounts []RoleAccount,
+	blockedContracts []BlockedContract,
+	frozenAccounts []FrozenAccount,
+	contractSponsors []ContractSponsor,
+) *GenesisState {
+	return &GenesisState{
+		RoleAccounts:     roleAccounts,
+		BlockedContracts: blockedContracts,
+		FrozenAccounts:   frozenAccounts,
+		ContractSponsors: contractSponsors,
+	}
+}
+
+// DefaultGenesisState gets the default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]RoleAccount{}, []BlockedContract{}, []FrozenAccount{}, []ContractSponsor{})
+}
+
+// ValidateGenesis validates the provided perm genesis state
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.RoleAccounts))
+	for _, ra := range data.RoleAccounts {
+		address, err := AccAddressFromString(ra.Address)
+		if err != nil {
+			return err
+		}
+		if seen[address.String()] {
+			return fmt.Errorf("duplicate role account: %s", ra.Address)
+		}
+		seen[address.String()] = true
+
+		if err := ValidateRoles(ra.Roles); err != nil {
+			return err
+		}
+	}
+
+	seenContracts := make(map[common.Address]bool, len(data.BlockedContracts))
+	for _, bc := range data.BlockedContracts {
+		if err := ValidateContractAddress(bc.Address); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(bc.Operator); err != nil {
+			return err
+		}
+		if bc.Height < 0 {
+			return fmt.Errorf("invalid height %d of blocked contract %s", bc.Height, bc.Address)
+		}
+
+		addr := common.HexToAddress(bc.Address)
+		if seenContracts[addr] {
+			return fmt.Errorf("duplicate blocked contract: %s", bc.Address)
+		}
+		seenContracts[addr] = true
+	}
+
+	seenFrozen := make(map[string]bool, len(data.FrozenAccounts))
+	for _, fa := range data.FrozenAccounts {
+		address, err := AccAddressFromString(fa.Address)
+		if err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(fa.Operator); err != nil {
+			return err
+		}
+		if err := ValidateReason(fa.Reason); err != nil {
+			return err
+		}
+		if fa.Height < 0 {
+			return fmt.Errorf("invalid height %d of frozen account %s", fa.Height, fa.Address)
+		}
+
+		if seenFrozen[address.String()] {
+			return fmt.Errorf("duplicate frozen account: %s", fa.Address)
+		}
+		seenFrozen[address.String()] = true
+	}
+
+	seenSponsored := make(map[common.Address]bool, len(data.ContractSponsors))
+	for _, cs := range data.ContractSponsors {
+		if err := ValidateContractAddress(cs.Contract); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(cs.Sponsor); err != nil {
+			return err
+		}
+		if err := ValidateSpendLimit(cs.SpendLimit); err != nil {
+			return err
+		}
+
+		addr := common.HexToAddress(cs.Contract)
+		if seenSponsored[addr] {
+			return fmt.Errorf("duplicate contract sponsor: %s", cs.Contract)
+		}
+		seenSponsored[addr] = true
+	}
+	return nil
+}
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: perm/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the perm module's genesis state
+type GenesisState struct {
+	RoleAccounts     []RoleAccount     `protobuf:"bytes,1,rep,name=role_accounts,json=roleAccounts,proto3" json:"role_accounts"`
+	BlockedContracts []BlockedContract `protobuf:"bytes,2,rep,name=blocked_contracts,json=blockedContracts,proto3" json:"blocked_contracts"`
+	FrozenAccounts   []FrozenAccount   `protobuf:"bytes,3,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts"`
+	ContractSponsors []ContractSponsor `protobuf:"bytes,4,rep,name=contract_sponsors,json=contractSponsors,proto3" json:"contract_sponsors"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51149f02dd396219, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetRoleAccounts() []RoleAccount {
+	if m != nil {
+		return m.RoleAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedContracts() []BlockedContract {
+	if m != nil {
+		return m.BlockedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractSponsors() []ContractSponsor {
+	if m != nil {
+		return m.ContractSponsors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "irita.perm.GenesisState")
+}
+
+func init() { proto.RegisterFile("perm/genesis.proto", fileDescriptor_51149f02dd396219) }
+
+var fileDescriptor_51149f02dd396219 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0x93, 0xb6, 0xfa, 0x07, 0xff, 0x85, 0x42, 0x84, 0x44, 0x29, 0x92, 0x41, 0x4c, 0x2c,
+	0xc4, 0x12, 0x3c, 0x01, 0x41, 0xa2, 0x4c, 0x0c, 0xed, 0xc6, 0x52, 0x39, 0xae, 0x1b, 0x0c, 0xa9,
+	0x6f, 0xe4, 0xeb, 0x0e, 0xf0, 0x14, 0x3c, 0x10, 0x0f, 0xd0, 0xb1, 0x23, 0x13, 0x42, 0xed, 0x8b,
+	0xa0, 0xda, 0x8d, 0x92, 0x88, 0xe5, 0xea, 0xea, 0x9c, 0xa3, 0xef, 0x1e, 0xe9, 0x92, 0xa8, 0x90,
+	0x66, 0xce, 0x32, 0xa9, 0x25, 0x2a, 0x8c, 0x0b, 0x03, 0x16, 0x22, 0xa2, 0x8c, 0xb2, 0x3c, 0xde,
+	0x3a, 0x83, 0x9e, 0xf3, 0xb7, 0xc3, 0x9b, 0x83, 0xa3, 0x0c, 0x32, 0x70, 0x2b, 0xdb, 0x6e, 0x5e,
+	0xbd, 0xf8, 0x6c, 0x91, 0xee, 0xd0, 0x43, 0xc6, 0x96, 0x5b, 0x19, 0x25, 0x64, 0xcf, 0x40, 0x2e,
+	0x27, 0x5c, 0x08, 0x58, 0x68, 0x8b, 0xfd, 0xf0, 0xbc, 0x7d, 0xf9, 0xff, 0xfa, 0x38, 0xae, 0xd8,
+	0xf1, 0x08, 0x72, 0x79, 0xeb, 0xfd, 0xa4, 0xb3, 0xfc, 0x3e, 0x0b, 0x46, 0x5d, 0x53, 0x49, 0x18,
+	0x3d, 0x92, 0xc3, 0x34, 0x07, 0xf1, 0x2a, 0xa7, 0x13, 0x01, 0xda, 0x1a, 0x2e, 0x2c, 0xf6, 0x5b,
+	0x8e, 0x73, 0x5a, 0xe7, 0x24, 0x3e, 0x74, 0xb7, 0xcb, 0xec, 0x58, 0x07, 0x69, 0x53, 0xc6, 0xe8,
+	0x81, 0xf4, 0x66, 0x06, 0xde, 0xa5, 0xae, 0x5a, 0xb5, 0x1d, 0xed, 0xa4, 0x4e, 0xbb, 0x77, 0x91,
+	0x66, 0xaf, 0xfd, 0x59, 0x5d, 0x74, 0xcd, 0xca, 0x46, 0x13, 0x2c, 0x40, 0x23, 0x18, 0xec, 0x77,
+	0xfe, 0x36, 0x2b, 0x6f, 0x8f, 0x7d, 0xa6, 0x6c, 0x26, 0x9a, 0x32, 0x26, 0xc3, 0xe5, 0x9a, 0x86,
+	0xab, 0x35, 0x0d, 0x7f, 0xd6, 0x34, 0xfc, 0xd8, 0xd0, 0x60, 0xb5, 0xa1, 0xc1, 0xd7, 0x86, 0x06,
+	0x4f, 0x57, 0x99, 0xb2, 0xcf, 0x8b, 0x34, 0x16, 0x30, 0x67, 0xa9, 0xe2, 0xfa, 0x45, 0x49, 0xae,
+	0x98, 0x3b, 0xc1, 0xe6, 0x30, 0x5d, 0xe4, 0x12, 0xdd, 0x77, 0x98, 0x7d, 0x2b, 0x24, 0xa6, 0xff,
+	0xdc, 0x3b, 0x6e, 0x7e, 0x07, 0x00, 0x1c, 0xbe, 0x5c, 0x56, 0xd7, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSponsors) > 0 {
+		for iNdEx := len(m.ContractSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockedContracts) > 0 {
+		for iNdEx := len(m.BlockedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RoleAccounts) > 0 {
+		for iNdEx := len(m.RoleAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleAccounts) > 0 {
+		for _, e := range m.RoleAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedContracts) > 0 {
+		for _, e := range m.BlockedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractSponsors) > 0 {
+		for _, e := range m.ContractSponsors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAccounts = append(m.RoleAccounts, RoleAccount{})
+			if err := m.RoleAccounts[len(m.RoleAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedContracts = append(m.BlockedContracts, BlockedContract{})
+			if err := m.BlockedContracts[len(m.BlockedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSponsors = append(m.ContractSponsors, ContractSponsor{})
+			if err := m.ContractSponsors[len(m.ContractSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the perm module
+	ModuleName = "perm"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the perm module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the perm module
+	RouterKey = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	RoleAccountKey     = []byte{0x01} // prefix for the accounts of a role
+	BlockedContractKey = []byte{0x02} // prefix for the blocked contracts
+	FrozenAccountKey   = []byte{0x03} // prefix for the frozen accounts
+	ContractSponsorKey = []byte{0x04} // prefix for the contract sponsors
+)
+
+// GetRoleAccountKey gets the key for the given account of the specified role
+// VALUE: []byte{}
+func GetRoleAccountKey(role Role, addr sdk.AccAddress) []byte {
+	return append(GetRoleAccountSubspace(role), address.MustLengthPrefix(addr)...)
+}
+
+// GetRoleAccountSubspace gets the key prefix for the accounts of the specified role
+func GetRoleAccountSubspace(role Role) []byte {
+	return append(RoleAccountKey, byte(role))
+}
+
+// AddressFromRoleAccountKey returns the account address stored in a role account key
+func AddressFromRoleAccountKey(key []byte) sdk.AccAddress {
+	// skip the prefix, the role and the address length
+	return key[3:]
+}
+
+// GetBlockedContractKey gets the key for the blocked contract with the given address
+// VALUE: perm/BlockedContract
+func GetBlockedContractKey(addr common.Address) []byte {
+	return append(BlockedContractKey, addr.Bytes()...)
+}
+
+// GetFrozenAccountKey gets the key for the frozen account with the given address
+// VALUE: perm/FrozenAccount
+func GetFrozenAccountKey(addr sdk.AccAddress) []byte {
+	return append(FrozenAccountKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetContractSponsorKey gets the key for the sponsor of the contract with the given address
+// VALUE: perm/ContractSponsor
+func GetContractSponsorKey(addr common.Address) []byte {
+	return append(ContractSponsorKey, addr.Bytes()...)
+}
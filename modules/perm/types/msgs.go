@@ -0,0 +1,392 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgAssignRoles     = "assign_roles"     // type for MsgAssignRoles
+	TypeMsgUnassignRoles   = "unassign_roles"   // type for MsgUnassignRoles
+	TypeMsgBlockContract   = "block_contract"   // type for MsgBlockContract
+	TypeMsgUnblockContract = "unblock_contract" // type for MsgUnblockContract
+
+	TypeMsgAddContractDeployer    = "add_contract_deployer"    // type for MsgAddContractDeployer
+	TypeMsgRemoveContractDeployer = "remove_contract_deployer" // type for MsgRemoveContractDeployer
+
+	TypeMsgFreezeAccount   = "freeze_account"   // type for MsgFreezeAccount
+	TypeMsgUnfreezeAccount = "unfreeze_account" // type for MsgUnfreezeAccount
+
+	TypeMsgSetContractSponsor    = "set_contract_sponsor"    // type for MsgSetContractSponsor
+	TypeMsgRemoveContractSponsor = "remove_contract_sponsor" // type for MsgRemoveContractSponsor
+)
+
+var (
+	_ sdk.Msg = &MsgAssignRoles{}
+	_ sdk.Msg = &MsgUnassignRoles{}
+	_ sdk.Msg = &MsgBlockContract{}
+	_ sdk.Msg = &MsgUnblockContract{}
+	_ sdk.Msg = &MsgAddContractDeployer{}
+	_ sdk.Msg = &MsgRemoveContractDeployer{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+	_ sdk.Msg = &MsgSetContractSponsor{}
+	_ sdk.Msg = &MsgRemoveContractSponsor{}
+)
+
+// NewMsgAssignRoles creates a new MsgAssignRoles instance.
+func NewMsgAssignRoles(roles []Role, address, operator string) *MsgAssignRoles {
+	return &MsgAssignRoles{
+		Address:  address,
+		Roles:    roles,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgAssignRoles) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgAssignRoles) Type() string { return TypeMsgAssignRoles }
+
+// ValidateBasic implements Msg.
+func (m MsgAssignRoles) ValidateBasic() error {
+	return validateRolesMsg(m.Address, m.Operator, m.Roles)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgAssignRoles) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgAssignRoles) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnassignRoles creates a new MsgUnassignRoles instance.
+func NewMsgUnassignRoles(roles []Role, address, operator string) *MsgUnassignRoles {
+	return &MsgUnassignRoles{
+		Address:  address,
+		Roles:    roles,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgUnassignRoles) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgUnassignRoles) Type() string { return TypeMsgUnassignRoles }
+
+// ValidateBasic implements Msg.
+func (m MsgUnassignRoles) ValidateBasic() error {
+	return validateRolesMsg(m.Address, m.Operator, m.Roles)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUnassignRoles) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUnassignRoles) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgBlockContract creates a new MsgBlockContract instance.
+func NewMsgBlockContract(contractAddress, operator string) *MsgBlockContract {
+	return &MsgBlockContract{
+		ContractAddress: contractAddress,
+		Operator:        operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgBlockContract) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgBlockContract) Type() string { return TypeMsgBlockContract }
+
+// ValidateBasic implements Msg.
+func (m MsgBlockContract) ValidateBasic() error {
+	return validateContractMsg(m.ContractAddress, m.Operator)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgBlockContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgBlockContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnblockContract creates a new MsgUnblockContract instance.
+func NewMsgUnblockContract(contractAddress, operator string) *MsgUnblockContract {
+	return &MsgUnblockContract{
+		ContractAddress: contractAddress,
+		Operator:        operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgUnblockContract) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgUnblockContract) Type() string { return TypeMsgUnblockContract }
+
+// ValidateBasic implements Msg.
+func (m MsgUnblockContract) ValidateBasic() error {
+	return validateContractMsg(m.ContractAddress, m.Operator)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUnblockContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUnblockContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddContractDeployer creates a new MsgAddContractDeployer instance.
+func NewMsgAddContractDeployer(address, operator string) *MsgAddContractDeployer {
+	return &MsgAddContractDeployer{
+		Address:  address,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgAddContractDeployer) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgAddContractDeployer) Type() string { return TypeMsgAddContractDeployer }
+
+// ValidateBasic implements Msg.
+func (m MsgAddContractDeployer) ValidateBasic() error {
+	return validateDeployerMsg(m.Address, m.Operator)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgAddContractDeployer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgAddContractDeployer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveContractDeployer creates a new MsgRemoveContractDeployer instance.
+func NewMsgRemoveContractDeployer(address, operator string) *MsgRemoveContractDeployer {
+	return &MsgRemoveContractDeployer{
+		Address:  address,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgRemoveContractDeployer) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgRemoveContractDeployer) Type() string { return TypeMsgRemoveContractDeployer }
+
+// ValidateBasic implements Msg.
+func (m MsgRemoveContractDeployer) ValidateBasic() error {
+	return validateDeployerMsg(m.Address, m.Operator)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRemoveContractDeployer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRemoveContractDeployer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgFreezeAccount creates a new MsgFreezeAccount instance.
+func NewMsgFreezeAccount(address, reason, operator string) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Address:  address,
+		Reason:   reason,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgFreezeAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgFreezeAccount) Type() string { return TypeMsgFreezeAccount }
+
+// ValidateBasic implements Msg.
+func (m MsgFreezeAccount) ValidateBasic() error {
+	if err := validateFreezeMsg(m.Address, m.Operator); err != nil {
+		return err
+	}
+	return ValidateReason(m.Reason)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgFreezeAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnfreezeAccount creates a new MsgUnfreezeAccount instance.
+func NewMsgUnfreezeAccount(address, operator string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Address:  address,
+		Operator: operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgUnfreezeAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgUnfreezeAccount) Type() string { return TypeMsgUnfreezeAccount }
+
+// ValidateBasic implements Msg.
+func (m MsgUnfreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(m.Address, m.Operator)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUnfreezeAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetContractSponsor creates a new MsgSetContractSponsor instance.
+func NewMsgSetContractSponsor(contractAddress string, spendLimit sdk.Coins, sponsor string) *MsgSetContractSponsor {
+	return &MsgSetContractSponsor{
+		ContractAddress: contractAddress,
+		SpendLimit:      spendLimit,
+		Sponsor:         sponsor,
+	}
+}
+
+// Route implements Msg.
+func (m MsgSetContractSponsor) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgSetContractSponsor) Type() string { return TypeMsgSetContractSponsor }
+
+// ValidateBasic implements Msg.
+func (m MsgSetContractSponsor) ValidateBasic() error {
+	if err := validateContractMsg(m.ContractAddress, m.Sponsor); err != nil {
+		return err
+	}
+	return ValidateSpendLimit(m.SpendLimit)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgSetContractSponsor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgSetContractSponsor) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sponsor)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveContractSponsor creates a new MsgRemoveContractSponsor instance.
+func NewMsgRemoveContractSponsor(contractAddress, operator string) *MsgRemoveContractSponsor {
+	return &MsgRemoveContractSponsor{
+		ContractAddress: contractAddress,
+		Operator:        operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgRemoveContractSponsor) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgRemoveContractSponsor) Type() string { return TypeMsgRemoveContractSponsor }
+
+// ValidateBasic implements Msg.
+func (m MsgRemoveContractSponsor) ValidateBasic() error {
+	return validateContractMsg(m.ContractAddress, m.Operator)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRemoveContractSponsor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRemoveContractSponsor) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+func validateRolesMsg(address, operator string, roles []Role) error {
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return ValidateRoles(roles)
+}
+
+func validateContractMsg(contractAddress, operator string) error {
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return ValidateContractAddress(contractAddress)
+}
+
+func validateDeployerMsg(address, operator string) error {
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if _, err := AccAddressFromString(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid deployer address (%s)", err)
+	}
+	return nil
+}
+
+func validateFreezeMsg(address, operator string) error {
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if _, err := AccAddressFromString(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
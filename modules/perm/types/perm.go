@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxReasonLength is the maximum length of a freeze reason
+const MaxReasonLength = 280
+
+// roleManagers defines, for each role, the roles other than the root admin
+// which are allowed to assign and unassign it
+var roleManagers = map[Role][]Role{
+	RoleRootAdmin:        {},
+	RolePermAdmin:        {},
+	RoleTIBCAdmin:        {RolePermAdmin, RoleTIBCAdmin},
+	RoleContractAdmin:    {RolePermAdmin, RoleContractAdmin},
+	RoleContractDeployer: {RolePermAdmin, RoleContractAdmin},
+	RoleComplianceAdmin:  {RolePermAdmin},
+	RoleProposalAdmin:    {RolePermAdmin},
+	RoleFeeAdmin:         {RolePermAdmin},
+	RoleMsgAdmin:         {RolePermAdmin},
+	RoleERC20Admin:       {RolePermAdmin},
+}
+
+// NewRoleAccount constructs a new RoleAccount instance
+func NewRoleAccount(address string, roles []Role) RoleAccount {
+	return RoleAccount{
+		Address: address,
+		Roles:   roles,
+	}
+}
+
+// AllRoles returns all the defined roles in ascending order
+func AllRoles() []Role {
+	roles := make([]Role, 0, len(Role_name))
+	for role := range Role_name {
+		roles = append(roles, Role(role))
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i] < roles[j] })
+	return roles
+}
+
+// IsValid returns true if the role is defined
+func (r Role) IsValid() bool {
+	_, ok := Role_name[int32(r)]
+	return ok
+}
+
+// ManagerRoles returns the roles, besides the root admin, which can manage the role
+func (r Role) ManagerRoles() []Role {
+	return roleManagers[r]
+}
+
+// RoleFromString parses a role from its name, e.g. "TIBC_ADMIN"
+func RoleFromString(str string) (Role, error) {
+	if role, ok := Role_value[strings.ToUpper(strings.TrimSpace(str))]; ok {
+		return Role(role), nil
+	}
+	return 0, sdkerrors.Wrapf(ErrInvalidRole, "unknown role: %s", str)
+}
+
+// RolesFromString parses a comma separated list of roles
+func RolesFromString(str string) ([]Role, error) {
+	var roles []Role
+	for _, s := range strings.Split(str, ",") {
+		role, err := RoleFromString(s)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// ValidateRoles checks that the given roles are defined and not duplicated
+func ValidateRoles(roles []Role) error {
+	if len(roles) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRole, "roles missing")
+	}
+
+	seen := make(map[Role]bool, len(roles))
+	for _, role := range roles {
+		if !role.IsValid() {
+			return sdkerrors.Wrapf(ErrInvalidRole, "unknown role: %d", role)
+		}
+		if seen[role] {
+			return sdkerrors.Wrapf(ErrInvalidRole, "duplicate role: %s", role)
+		}
+		seen[role] = true
+	}
+	return nil
+}
+
+// RolesString returns the human readable form of the given roles
+func RolesString(roles []Role) string {
+	strs := make([]string, len(roles))
+	for i, role := range roles {
+		strs[i] = role.String()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(strs, ","))
+}
+
+// NewBlockedContract constructs a new BlockedContract instance
+func NewBlockedContract(address, operator string, height int64) BlockedContract {
+	return BlockedContract{
+		Address:  address,
+		Operator: operator,
+		Height:   height,
+	}
+}
+
+// ValidateContractAddress checks that the given string is a hex contract address
+func ValidateContractAddress(address string) error {
+	if !common.IsHexAddress(address) {
+		return sdkerrors.Wrapf(ErrInvalidContractAddress, "%s is not a hex address", address)
+	}
+	return nil
+}
+
+// NewFrozenAccount constructs a new FrozenAccount instance
+func NewFrozenAccount(address, reason, operator string, frozenAt time.Time, height int64) FrozenAccount {
+	return FrozenAccount{
+		Address:  address,
+		Reason:   reason,
+		Operator: operator,
+		FrozenAt: frozenAt,
+		Height:   height,
+	}
+}
+
+// ValidateReason checks that the freeze reason is given and not too long
+func ValidateReason(reason string) error {
+	if len(strings.TrimSpace(reason)) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReason, "reason missing")
+	}
+	if len(reason) > MaxReasonLength {
+		return sdkerrors.Wrapf(ErrInvalidReason, "length of the reason must not be greater than %d", MaxReasonLength)
+	}
+	return nil
+}
+
+// AccAddressFromString parses an account address given either in bech32 or in hex
+func AccAddressFromString(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+	return sdk.AccAddressFromBech32(address)
+}
+
+// NewContractSponsor constructs a new ContractSponsor instance
+func NewContractSponsor(contract, sponsor string, spendLimit sdk.Coins) ContractSponsor {
+	return ContractSponsor{
+		Contract:   contract,
+		Sponsor:    sponsor,
+		SpendLimit: spendLimit,
+	}
+}
+
+// ValidateSpendLimit checks that the spend limit, if any, is valid and positive
+func ValidateSpendLimit(spendLimit sdk.Coins) error {
+	if spendLimit.Empty() {
+		return nil
+	}
+	if !spendLimit.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidSpendLimit, "%s", spendLimit)
+	}
+	return nil
+}
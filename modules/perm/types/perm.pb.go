@@ -0,0 +1,1489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: perm/perm.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role represents a role which can be granted to an account
+type Role int32
+
+const (
+	// ROOT_ADMIN is allowed to perform any permissioned operation and to manage
+	// all the other roles
+	RoleRootAdmin Role = 0
+	// PERM_ADMIN is allowed to manage all the roles except ROOT_ADMIN and PERM_ADMIN
+	RolePermAdmin Role = 1
+	// TIBC_ADMIN is allowed to create and upgrade TIBC clients, register relayers
+	// and set routing rules
+	RoleTIBCAdmin Role = 2
+	// CONTRACT_ADMIN is allowed to block and unblock EVM contracts
+	RoleContractAdmin Role = 3
+	// CONTRACT_DEPLOYER is allowed to deploy EVM contracts
+	RoleContractDeployer Role = 4
+	// COMPLIANCE_ADMIN is allowed to freeze and unfreeze accounts
+	RoleComplianceAdmin Role = 5
+	// PROPOSAL_ADMIN is allowed to submit and approve admin proposals
+	RoleProposalAdmin Role = 6
+	// FEE_ADMIN is allowed to register and remove the tokens accepted to pay the tx fees
+	RoleFeeAdmin Role = 7
+	// MSG_ADMIN is allowed to update the allowlist and denylist of the message types
+	RoleMsgAdmin Role = 8
+	// ERC20_ADMIN is allowed to register the token pairs of the erc20 module and to toggle their conversion
+	RoleERC20Admin Role = 9
+)
+
+var Role_name = map[int32]string{
+	0: "ROOT_ADMIN",
+	1: "PERM_ADMIN",
+	2: "TIBC_ADMIN",
+	3: "CONTRACT_ADMIN",
+	4: "CONTRACT_DEPLOYER",
+	5: "COMPLIANCE_ADMIN",
+	6: "PROPOSAL_ADMIN",
+	7: "FEE_ADMIN",
+	8: "MSG_ADMIN",
+	9: "ERC20_ADMIN",
+}
+
+var Role_value = map[string]int32{
+	"ROOT_ADMIN":        0,
+	"PERM_ADMIN":        1,
+	"TIBC_ADMIN":        2,
+	"CONTRACT_ADMIN":    3,
+	"CONTRACT_DEPLOYER": 4,
+	"COMPLIANCE_ADMIN":  5,
+	"PROPOSAL_ADMIN":    6,
+	"FEE_ADMIN":         7,
+	"MSG_ADMIN":         8,
+	"ERC20_ADMIN":       9,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb77ba30a3a45e51, []int{0}
+}
+
+// RoleAccount defines the roles granted to an account
+type RoleAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Roles   []Role `protobuf:"varint,2,rep,packed,name=roles,proto3,enum=irita.perm.Role" json:"roles,omitempty"`
+}
+
+func (m *RoleAccount) Reset()         { *m = RoleAccount{} }
+func (m *RoleAccount) String() string { return proto.CompactTextString(m) }
+func (*RoleAccount) ProtoMessage()    {}
+func (*RoleAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb77ba30a3a45e51, []int{0}
+}
+func (m *RoleAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleAccount.Merge(m, src)
+}
+func (m *RoleAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleAccount proto.InternalMessageInfo
+
+// BlockedContract defines an EVM contract which is not allowed to be called
+type BlockedContract struct {
+	// address is the hex address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// operator is the account which blocked the contract
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// height is the block height at which the contract was blocked
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlockedContract) Reset()         { *m = BlockedContract{} }
+func (m *BlockedContract) String() string { return proto.CompactTextString(m) }
+func (*BlockedContract) ProtoMessage()    {}
+func (*BlockedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb77ba30a3a45e51, []int{1}
+}
+func (m *BlockedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedContract.Merge(m, src)
+}
+func (m *BlockedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedContract proto.InternalMessageInfo
+
+// FrozenAccount defines an account which is not allowed to sign transactions
+// nor to receive transfers
+type FrozenAccount struct {
+	// address is the bech32 address of the frozen account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// reason is the reason why the account was frozen
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// operator is the account which froze the account
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// frozen_at is the block time at which the account was frozen
+	FrozenAt time.Time `protobuf:"bytes,4,opt,name=frozen_at,json=frozenAt,proto3,stdtime" json:"frozen_at" yaml:"frozen_at"`
+	// height is the block height at which the account was frozen
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FrozenAccount) Reset()         { *m = FrozenAccount{} }
+func (m *FrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccount) ProtoMessage()    {}
+func (*FrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb77ba30a3a45e51, []int{2}
+}
+func (m *FrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccount.Merge(m, src)
+}
+func (m *FrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccount proto.InternalMessageInfo
+
+// ContractSponsor defines an account paying the fees of the ethereum txs which
+// call an EVM contract
+type ContractSponsor struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// sponsor is the bech32 address of the account paying the fees
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// spend_limit is the amount of fees left to pay, unlimited if empty
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit" yaml:"spend_limit"`
+}
+
+func (m *ContractSponsor) Reset()         { *m = ContractSponsor{} }
+func (m *ContractSponsor) String() string { return proto.CompactTextString(m) }
+func (*ContractSponsor) ProtoMessage()    {}
+func (*ContractSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb77ba30a3a45e51, []int{3}
+}
+func (m *ContractSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSponsor.Merge(m, src)
+}
+func (m *ContractSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSponsor proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("irita.perm.Role", Role_name, Role_value)
+	proto.RegisterType((*RoleAccount)(nil), "irita.perm.RoleAccount")
+	proto.RegisterType((*BlockedContract)(nil), "irita.perm.BlockedContract")
+	proto.RegisterType((*FrozenAccount)(nil), "irita.perm.FrozenAccount")
+	proto.RegisterType((*ContractSponsor)(nil), "irita.perm.ContractSponsor")
+}
+
+func init() { proto.RegisterFile("perm/perm.proto", fileDescriptor_bb77ba30a3a45e51) }
+
+var fileDescriptor_bb77ba30a3a45e51 = []byte{
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x6f, 0xd3, 0x4a,
+	0x10, 0xc7, 0xe3, 0x26, 0x4d, 0x9b, 0xcd, 0x6b, 0x9b, 0xfa, 0xf5, 0xf5, 0x05, 0x0b, 0x39, 0x26,
+	0x48, 0x28, 0x20, 0xd5, 0xa6, 0xe1, 0xd6, 0x5b, 0xe2, 0x26, 0xa8, 0x22, 0x69, 0x22, 0x37, 0x3d,
+	0xc0, 0xa5, 0x72, 0x9c, 0x6d, 0x6a, 0x6a, 0x7b, 0x2c, 0xef, 0x06, 0xa9, 0x1c, 0x39, 0xa1, 0x9c,
+	0x2a, 0xee, 0x91, 0x90, 0xb8, 0xf1, 0x49, 0x7a, 0xac, 0x38, 0x71, 0x6a, 0xa1, 0xbd, 0x70, 0xe6,
+	0x13, 0xa0, 0xf5, 0xae, 0x93, 0x80, 0x10, 0x5c, 0x92, 0xfc, 0x67, 0x7f, 0x3b, 0x33, 0xff, 0xf1,
+	0x38, 0x68, 0x2d, 0xc4, 0x91, 0x6f, 0xb0, 0x0f, 0x3d, 0x8c, 0x80, 0x82, 0x8c, 0xdc, 0xc8, 0xa5,
+	0xb6, 0xce, 0x22, 0xca, 0xc6, 0x10, 0x86, 0x10, 0x87, 0x0d, 0xf6, 0x8b, 0x13, 0x8a, 0xea, 0x00,
+	0xf1, 0x81, 0x18, 0x7d, 0x9b, 0x60, 0xe3, 0xd5, 0x76, 0x1f, 0x53, 0x7b, 0xdb, 0x70, 0xc0, 0x0d,
+	0xc4, 0x79, 0x69, 0x08, 0x30, 0xf4, 0xb0, 0x11, 0xab, 0xfe, 0xe8, 0xd8, 0xa0, 0xae, 0x8f, 0x09,
+	0xb5, 0xfd, 0x90, 0x03, 0xe5, 0x43, 0x94, 0xb7, 0xc0, 0xc3, 0x35, 0xc7, 0x81, 0x51, 0x40, 0xe5,
+	0x22, 0x5a, 0xb2, 0x07, 0x83, 0x08, 0x13, 0x52, 0x94, 0x34, 0xa9, 0x92, 0xb3, 0x12, 0x29, 0x3f,
+	0x40, 0x8b, 0x11, 0x78, 0x98, 0x14, 0x17, 0xb4, 0x74, 0x65, 0xb5, 0x5a, 0xd0, 0x67, 0xbd, 0xe9,
+	0x2c, 0x83, 0xc5, 0x8f, 0x77, 0x32, 0xdf, 0xde, 0x97, 0xa4, 0x32, 0x46, 0x6b, 0x75, 0x0f, 0x9c,
+	0x53, 0x3c, 0x30, 0x21, 0xa0, 0x91, 0xed, 0xfc, 0x29, 0xb5, 0x82, 0x96, 0x21, 0xc4, 0x91, 0x4d,
+	0x21, 0x2a, 0x2e, 0xc4, 0x47, 0x53, 0x2d, 0x6f, 0xa2, 0xec, 0x09, 0x76, 0x87, 0x27, 0xb4, 0x98,
+	0xd6, 0xa4, 0x4a, 0xda, 0x12, 0x4a, 0x94, 0xf9, 0x24, 0xa1, 0x95, 0x66, 0x04, 0xaf, 0x71, 0xf0,
+	0x77, 0x03, 0x9b, 0x28, 0x1b, 0x61, 0x9b, 0x40, 0x20, 0x6a, 0x08, 0xf5, 0x53, 0xf5, 0xf4, 0x2f,
+	0xd5, 0x0f, 0x51, 0xee, 0x38, 0x4e, 0x7f, 0x64, 0xd3, 0x62, 0x46, 0x93, 0x2a, 0xf9, 0xaa, 0xa2,
+	0xf3, 0x91, 0xea, 0xc9, 0x48, 0xf5, 0x5e, 0x32, 0xd2, 0xfa, 0xdd, 0x8b, 0xab, 0x52, 0xea, 0xfb,
+	0x55, 0xa9, 0x70, 0x66, 0xfb, 0xde, 0x4e, 0x79, 0x7a, 0xb5, 0x7c, 0x7e, 0x5d, 0x92, 0xac, 0x65,
+	0xae, 0x6b, 0x74, 0xce, 0xd4, 0xe2, 0xef, 0x4d, 0xad, 0x25, 0x53, 0x3b, 0x08, 0x21, 0x20, 0x10,
+	0xb1, 0x26, 0x1d, 0x11, 0x12, 0xbe, 0xa6, 0x9a, 0x59, 0x26, 0x1c, 0x13, 0xce, 0x12, 0x29, 0xbf,
+	0x91, 0x50, 0x9e, 0x84, 0x38, 0x18, 0x1c, 0x79, 0xae, 0xef, 0xb2, 0x11, 0xa6, 0x2b, 0xf9, 0xea,
+	0x1d, 0x9d, 0x2f, 0x8d, 0xce, 0x96, 0x46, 0x17, 0x4b, 0xa3, 0x9b, 0xe0, 0x06, 0xf5, 0xa6, 0x30,
+	0x20, 0x73, 0x03, 0x73, 0x77, 0xcb, 0x1f, 0xaf, 0x4b, 0x95, 0xa1, 0x4b, 0x4f, 0x46, 0x7d, 0xdd,
+	0x01, 0xdf, 0x10, 0x7b, 0xc7, 0xbf, 0xb6, 0xc8, 0xe0, 0xd4, 0xa0, 0x67, 0x21, 0x26, 0x71, 0x1a,
+	0x62, 0xa1, 0xf8, 0x66, 0x8b, 0x5d, 0xe4, 0xa6, 0x1e, 0xbd, 0x4b, 0xa3, 0x0c, 0x5b, 0x13, 0xf9,
+	0x1e, 0x42, 0x56, 0xa7, 0xd3, 0x3b, 0xaa, 0xed, 0xb6, 0xf7, 0xf6, 0x0b, 0x29, 0x65, 0x7d, 0x3c,
+	0xd1, 0x56, 0xd8, 0x89, 0x05, 0x40, 0x6b, 0x03, 0xdf, 0x0d, 0x18, 0xd2, 0x6d, 0x58, 0x6d, 0x81,
+	0x48, 0x33, 0xa4, 0x8b, 0x23, 0x7f, 0x8a, 0xf4, 0xf6, 0xea, 0xa6, 0x40, 0x16, 0x66, 0x08, 0x8b,
+	0x72, 0xe4, 0x21, 0x5a, 0x35, 0x3b, 0xfb, 0x3d, 0xab, 0x66, 0x26, 0xc5, 0xd2, 0xca, 0x7f, 0xe3,
+	0x89, 0xb6, 0xce, 0xb0, 0x64, 0xbe, 0x1c, 0x35, 0xd0, 0xfa, 0x14, 0xdd, 0x6d, 0x74, 0x5b, 0x9d,
+	0xe7, 0x0d, 0xab, 0x90, 0x51, 0x8a, 0xe3, 0x89, 0xb6, 0x31, 0x4f, 0xef, 0xe2, 0xd0, 0x83, 0x33,
+	0x1c, 0xc9, 0x5b, 0xa8, 0x60, 0x76, 0xda, 0xdd, 0xd6, 0x5e, 0x6d, 0xdf, 0x6c, 0x88, 0xec, 0x8b,
+	0xca, 0xff, 0xe3, 0x89, 0xf6, 0x2f, 0xe7, 0xfd, 0xd0, 0x73, 0xed, 0xc0, 0xc1, 0xd3, 0x56, 0xba,
+	0x56, 0xa7, 0xdb, 0x39, 0xa8, 0xb5, 0x04, 0x9c, 0x9d, 0xb5, 0xd2, 0x8d, 0x20, 0x04, 0x62, 0x7b,
+	0x1c, 0x2d, 0xa1, 0x5c, 0xb3, 0x91, 0xa4, 0x5c, 0x52, 0x0a, 0xe3, 0x89, 0xf6, 0x0f, 0xa3, 0x9a,
+	0x18, 0x4f, 0x81, 0xf6, 0xc1, 0x53, 0x01, 0x2c, 0xcf, 0x80, 0x36, 0x19, 0x72, 0xe0, 0x3e, 0xca,
+	0x37, 0x2c, 0xb3, 0xfa, 0x58, 0x20, 0x39, 0x45, 0x1e, 0x4f, 0xb4, 0x55, 0x86, 0xc4, 0xe1, 0x18,
+	0x52, 0x32, 0x6f, 0x3f, 0xa8, 0xa9, 0xfa, 0xb3, 0x8b, 0xaf, 0x6a, 0xea, 0xe2, 0x46, 0x95, 0x2e,
+	0x6f, 0x54, 0xe9, 0xcb, 0x8d, 0x2a, 0x9d, 0xdf, 0xaa, 0xa9, 0xcb, 0x5b, 0x35, 0xf5, 0xf9, 0x56,
+	0x4d, 0xbd, 0xd8, 0x9a, 0x7b, 0xdc, 0x7d, 0xd7, 0x0e, 0x5e, 0xba, 0xd8, 0x76, 0x8d, 0xf8, 0xb5,
+	0x37, 0x7c, 0x18, 0x8c, 0x3c, 0x4c, 0xe2, 0x3f, 0x2b, 0xfe, 0xe4, 0xfb, 0xd9, 0xf8, 0x85, 0x78,
+	0xf2, 0x63, 0x00, 0xb3, 0x4b, 0x85, 0xac, 0xc6, 0x04, 0x00, 0x00,
+}
+
+func (this *RoleAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleAccount)
+	if !ok {
+		that2, ok := that.(RoleAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if this.Roles[i] != that1.Roles[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockedContract) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockedContract)
+	if !ok {
+		that2, ok := that.(BlockedContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *FrozenAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FrozenAccount)
+	if !ok {
+		that2, ok := that.(FrozenAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if !this.FrozenAt.Equal(that1.FrozenAt) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *ContractSponsor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractSponsor)
+	if !ok {
+		that2, ok := that.(ContractSponsor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Sponsor != that1.Sponsor {
+		return false
+	}
+	if len(this.SpendLimit) != len(that1.SpendLimit) {
+		return false
+	}
+	for i := range this.SpendLimit {
+		if !this.SpendLimit[i].Equal(&that1.SpendLimit[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *RoleAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA2 := make([]byte, len(m.Roles)*10)
+		var j1 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPerm(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPerm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPerm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FrozenAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPerm(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPerm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintPerm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPerm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPerm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovPerm(uint64(e))
+		}
+		n += 1 + sovPerm(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BlockedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPerm(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenAt)
+	n += 1 + l + sovPerm(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovPerm(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ContractSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovPerm(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovPerm(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPerm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPerm(x uint64) (n int) {
+	return sovPerm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPerm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPerm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPerm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPerm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPerm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FrozenAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPerm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPerm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPerm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPerm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPerm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPerm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPerm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPerm = fmt.Errorf("proto: unexpected end of group")
+)
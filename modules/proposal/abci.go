@@ -0,0 +1,12 @@
+package proposal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/proposal/keeper"
+)
+
+// EndBlocker expires the pending proposals whose voting period has ended
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ExpireProposals(ctx)
+}
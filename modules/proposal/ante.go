@@ -0,0 +1,79 @@
+package proposal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	paramstypes "github.com/bianjieai/iritamod/modules/params/types"
+	upgradetypes "github.com/bianjieai/iritamod/modules/upgrade/types"
+
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// maxNestingDepth is the maximum depth of the messages nested in other messages
+const maxNestingDepth = 5
+
+// authorityMsgTypes are the types of the messages which must be signed by the
+// authority, i.e. which can only be executed through the admin proposals
+var authorityMsgTypes = map[string]bool{
+	sdk.MsgTypeURL(&paramstypes.MsgUpdateParams{}):     true,
+	sdk.MsgTypeURL(&upgradetypes.MsgUpgradeSoftware{}): true,
+	sdk.MsgTypeURL(&upgradetypes.MsgCancelUpgrade{}):   true,
+}
+
+// msgsWrapper is implemented by the messages nesting other messages, such as the
+// authz executions and the proposals
+type msgsWrapper interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// AuthorityDecorator rejects the txs holding params or upgrade messages, including
+// the messages nested in other messages, which are not signed by the authority,
+// i.e. the proposal module account executing the approved messages
+type AuthorityDecorator struct {
+	authority sdk.AccAddress
+}
+
+// NewAuthorityDecorator creates a new AuthorityDecorator
+func NewAuthorityDecorator(authority sdk.AccAddress) AuthorityDecorator {
+	return AuthorityDecorator{
+		authority: authority,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (ad AuthorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := ad.validateMsgs(tx.GetMsgs(), 0); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (ad AuthorityDecorator) validateMsgs(msgs []sdk.Msg, depth int) error {
+	if depth > maxNestingDepth {
+		return sdkerrors.Wrapf(types.ErrInvalidMessage, "messages nested more than %d times", maxNestingDepth)
+	}
+
+	for _, msg := range msgs {
+		if msgTypeURL := sdk.MsgTypeURL(msg); authorityMsgTypes[msgTypeURL] {
+			for _, signer := range msg.GetSigners() {
+				if !signer.Equals(ad.authority) {
+					return sdkerrors.Wrapf(types.ErrUnauthorized, "%s must be signed by the authority %s", msgTypeURL, ad.authority)
+				}
+			}
+		}
+
+		wrapper, ok := msg.(msgsWrapper)
+		if !ok {
+			continue
+		}
+		nested, err := wrapper.GetMessages()
+		if err != nil {
+			return err
+		}
+		if err := ad.validateMsgs(nested, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// FlagStatus defines the flag filtering the proposals by status
+const FlagStatus = "status"
+
+// GetQueryCmd returns the query commands for the proposal module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the admin proposal module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryProposal(),
+		GetCmdQueryProposals(),
+		GetCmdQueryParams(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryProposal implements the query proposal command.
+func GetCmdQueryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposal [proposal-id]",
+		Short:   "Query an admin proposal",
+		Example: "$ irita query proposal proposal 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Proposal(context.Background(), &types.QueryProposalRequest{ProposalId: proposalID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Proposal)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposals implements the query proposals command.
+func GetCmdQueryProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposals",
+		Short:   "Query the admin proposals, optionally filtered by status",
+		Example: "$ irita query proposal proposals --status=pending",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := types.StatusNil
+			if statusStr, _ := cmd.Flags().GetString(FlagStatus); statusStr != "" {
+				if status, err = types.ProposalStatusFromString(statusStr); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Proposals(context.Background(), &types.QueryProposalsRequest{
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "filter the proposals by status (pending|executed|failed|cancelled|expired)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals")
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the parameters of the admin proposal module",
+		Example: "$ irita query proposal params",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
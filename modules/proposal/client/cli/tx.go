@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// proposal defines the content of a proposal file
+type proposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []json.RawMessage `json:"messages"`
+}
+
+// NewTxCmd returns the transaction commands for the proposal module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Admin proposal transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSubmitProposalCmd(),
+		NewApproveProposalCmd(),
+		NewCancelProposalCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSubmitProposalCmd implements the submit proposal command.
+func NewSubmitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [proposal-file]",
+		Short: "Submit an admin proposal of messages executed once approved by the threshold of admins",
+		Long: `Submit an admin proposal along with the messages to execute. The messages must be
+signed by the proposal module account only. The proposal file has the following format:
+
+{
+  "title": "Upgrade the chain",
+  "description": "Upgrade to v5",
+  "messages": [
+    {
+      "@type": "/iritamod.upgrade.MsgUpgradeSoftware",
+      "name": "v5",
+      "height": "1000000",
+      "info": "",
+      "operator": "<proposal module account address>"
+    }
+  ]
+}`,
+		Example: "$ irita tx proposal submit-proposal proposal.json --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, msgs, err := parseProposalFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(msgs, title, description, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewApproveProposalCmd implements the approve proposal command.
+func NewApproveProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approve [proposal-id]",
+		Short:   "Approve a pending admin proposal",
+		Example: "$ irita tx proposal approve 1 --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[0], err)
+			}
+
+			msg := types.NewMsgApproveProposal(proposalID, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelProposalCmd implements the cancel proposal command.
+func NewCancelProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel [proposal-id]",
+		Short:   "Cancel a pending admin proposal",
+		Example: "$ irita tx proposal cancel 1 --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[0], err)
+			}
+
+			msg := types.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseProposalFile reads and parses the proposal file
+func parseProposalFile(cdc codec.Codec, path string) (string, string, []sdk.Msg, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	var p proposal
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return "", "", nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, rawMsg := range p.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return "", "", nil, fmt.Errorf("failed to parse message %d: %w", i, err)
+		}
+		msgs[i] = msg
+	}
+
+	return p.Title, p.Description, msgs, nil
+}
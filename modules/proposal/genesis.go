@@ -0,0 +1,37 @@
+package proposal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/proposal/keeper"
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// InitGenesis stores the genesis params and proposals
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	// create the module account which executes the proposals if it does not exist
+	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	k.SetParams(ctx, data.Params)
+	k.SetProposalID(ctx, data.StartingProposalId)
+
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.Status == types.StatusPending {
+			k.InsertActiveProposalQueue(ctx, proposal.Id, proposal.VotingEndTime)
+		}
+	}
+}
+
+// ExportGenesis outputs the params and proposals
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	startingProposalID, err := k.GetProposalID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(k.GetParams(ctx), startingProposalID, k.GetProposals(ctx))
+}
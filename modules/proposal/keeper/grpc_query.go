@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Proposal queries the admin proposal with the given id
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.ProposalId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	return &types.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+// Proposals queries the admin proposals, optionally filtered by status
+func (k Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if !req.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proposal status: %d", req.Status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalKey)
+
+	var proposals []types.Proposal
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var proposal types.Proposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return false, err
+		}
+
+		if req.Status != types.StatusNil && proposal.Status != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			proposals = append(proposals, proposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// Params queries the parameters of the proposal module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// Keeper defines the proposal keeper
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           codec.Codec
+	paramSpace    paramstypes.Subspace
+	accountKeeper types.AccountKeeper
+	permKeeper    types.PermKeeper
+	router        *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new proposal Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	paramSpace paramstypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	permKeeper types.PermKeeper,
+	router *baseapp.MsgServiceRouter,
+) Keeper {
+	// ensure the proposal module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		permKeeper:    permKeeper,
+		router:        router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// GetModuleAddress returns the address of the proposal module account, which
+// is the signer of the messages executed by the proposals
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// GetParams returns the proposal module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the proposal module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	paramstypes "github.com/bianjieai/iritamod/modules/params/types"
+	upgradetypes "github.com/bianjieai/iritamod/modules/upgrade/types"
+
+	permkeeper "github.com/bianjieai/irita/modules/perm/keeper"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/modules/proposal"
+	"github.com/bianjieai/irita/modules/proposal/keeper"
+	"github.com/bianjieai/irita/modules/proposal/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+var (
+	admin1 = testutil.Addr("admin1")
+	admin2 = testutil.Addr("admin2")
+	admin3 = testutil.Addr("admin3")
+	user   = testutil.Addr("user")
+
+	votingPeriod = time.Hour
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	permKeeper permkeeper.Keeper
+	moduleAddr sdk.AccAddress
+	txConfig   client.TxConfig
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), map[string][]string{types.ModuleName: nil}, types.StoreKey)
+	suite.ctx = env.Ctx
+	suite.permKeeper = env.PermKeeper
+	suite.txConfig = env.EncodingConfig.TxConfig
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(env.EncodingConfig.InterfaceRegistry)
+	permtypes.RegisterMsgServer(router, permkeeper.NewMsgServerImpl(suite.permKeeper))
+
+	suite.keeper = keeper.NewKeeper(
+		env.Cdc, env.Key(types.StoreKey), env.Subspace(types.ModuleName), env.AccountKeeper, suite.permKeeper, router,
+	)
+	suite.keeper.SetParams(suite.ctx, types.NewParams(2, votingPeriod))
+	suite.keeper.SetProposalID(suite.ctx, types.DefaultStartingProposalID)
+
+	suite.moduleAddr = suite.keeper.GetModuleAddress()
+	for _, admin := range []sdk.AccAddress{admin1, admin2, admin3} {
+		suite.permKeeper.SetRole(suite.ctx, admin, permtypes.RoleProposalAdmin)
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) assignRolesMsg(roles ...permtypes.Role) []sdk.Msg {
+	return []sdk.Msg{permtypes.NewMsgAssignRoles(roles, user.String(), suite.moduleAddr.String())}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposal() {
+	msgs := suite.assignRolesMsg(permtypes.RoleTIBCAdmin)
+
+	_, err := suite.keeper.SubmitProposal(suite.ctx, msgs, "title", "", user)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	// the messages must be signed by the module account
+	invalidMsgs := []sdk.Msg{permtypes.NewMsgAssignRoles([]permtypes.Role{permtypes.RoleTIBCAdmin}, user.String(), admin1.String())}
+	_, err = suite.keeper.SubmitProposal(suite.ctx, invalidMsgs, "title", "", admin1)
+	suite.ErrorIs(err, types.ErrInvalidMessage)
+
+	proposal, err := suite.keeper.SubmitProposal(suite.ctx, msgs, "title", "", admin1)
+	suite.NoError(err)
+	suite.Equal(uint64(1), proposal.Id)
+	suite.Equal(types.StatusPending, proposal.Status)
+	suite.Equal([]string{admin1.String()}, proposal.Approvals)
+	suite.Equal(suite.ctx.BlockHeader().Time.Add(votingPeriod), proposal.VotingEndTime)
+	suite.False(suite.permKeeper.HasRole(suite.ctx, user, permtypes.RoleTIBCAdmin))
+
+	_, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin1)
+	suite.ErrorIs(err, types.ErrAlreadyApproved)
+
+	_, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, user)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	proposal, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin2)
+	suite.NoError(err)
+	suite.Equal(types.StatusExecuted, proposal.Status)
+	suite.True(suite.permKeeper.HasRole(suite.ctx, user, permtypes.RoleTIBCAdmin))
+
+	_, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin3)
+	suite.ErrorIs(err, types.ErrInactiveProposal)
+}
+
+func (suite *KeeperTestSuite) TestFailedProposal() {
+	// the user has no TIBC admin role to unassign, so the assigned role is discarded
+	msgs := append(
+		suite.assignRolesMsg(permtypes.RolePermAdmin),
+		permtypes.NewMsgUnassignRoles([]permtypes.Role{permtypes.RoleTIBCAdmin}, user.String(), suite.moduleAddr.String()),
+	)
+	proposal, err := suite.keeper.SubmitProposal(suite.ctx, msgs, "title", "", admin1)
+	suite.NoError(err)
+
+	proposal, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin2)
+	suite.NoError(err)
+	suite.Equal(types.StatusFailed, proposal.Status)
+	suite.NotEmpty(proposal.FailureReason)
+	suite.False(suite.permKeeper.HasRole(suite.ctx, user, permtypes.RolePermAdmin))
+}
+
+func (suite *KeeperTestSuite) TestRevokedAdminApproval() {
+	proposal, err := suite.keeper.SubmitProposal(suite.ctx, suite.assignRolesMsg(permtypes.RoleTIBCAdmin), "title", "", admin1)
+	suite.NoError(err)
+
+	// the approvals of the revoked admins are not counted
+	suite.permKeeper.DeleteRole(suite.ctx, admin1, permtypes.RoleProposalAdmin)
+
+	proposal, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin2)
+	suite.NoError(err)
+	suite.Equal(types.StatusPending, proposal.Status)
+
+	proposal, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin3)
+	suite.NoError(err)
+	suite.Equal(types.StatusExecuted, proposal.Status)
+}
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposal, err := suite.keeper.SubmitProposal(suite.ctx, suite.assignRolesMsg(permtypes.RoleTIBCAdmin), "title", "", admin1)
+	suite.NoError(err)
+
+	err = suite.keeper.CancelProposal(suite.ctx, proposal.Id, admin2)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.CancelProposal(suite.ctx, proposal.Id, admin1)
+	suite.NoError(err)
+
+	proposal, found := suite.keeper.GetProposal(suite.ctx, proposal.Id)
+	suite.True(found)
+	suite.Equal(types.StatusCancelled, proposal.Status)
+
+	_, err = suite.keeper.ApproveProposal(suite.ctx, proposal.Id, admin2)
+	suite.ErrorIs(err, types.ErrInactiveProposal)
+}
+
+func (suite *KeeperTestSuite) TestExpireProposals() {
+	proposal, err := suite.keeper.SubmitProposal(suite.ctx, suite.assignRolesMsg(permtypes.RoleTIBCAdmin), "title", "", admin1)
+	suite.NoError(err)
+
+	suite.keeper.ExpireProposals(suite.ctx)
+	proposal, _ = suite.keeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Equal(types.StatusPending, proposal.Status)
+
+	ctx := suite.ctx.WithBlockTime(proposal.VotingEndTime)
+
+	_, err = suite.keeper.ApproveProposal(ctx, proposal.Id, admin2)
+	suite.ErrorIs(err, types.ErrInactiveProposal)
+
+	suite.keeper.ExpireProposals(ctx)
+	proposal, _ = suite.keeper.GetProposal(ctx, proposal.Id)
+	suite.Equal(types.StatusExpired, proposal.Status)
+	suite.False(suite.permKeeper.HasRole(ctx, user, permtypes.RoleTIBCAdmin))
+}
+
+func (suite *KeeperTestSuite) TestAuthorityDecorator() {
+	decorator := proposal.NewAuthorityDecorator(suite.moduleAddr)
+	validate := func(msgs ...sdk.Msg) error {
+		builder := suite.txConfig.NewTxBuilder()
+		suite.Require().NoError(builder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(suite.ctx, builder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	// the params and upgrade messages are rejected unless signed by the authority
+	for _, operator := range []sdk.AccAddress{user, admin1} {
+		updateParams := paramstypes.NewMsgUpdateParams(nil, operator)
+		suite.ErrorIs(validate(updateParams), types.ErrUnauthorized)
+		suite.ErrorIs(validate(upgradetypes.NewMsgUpgradeSoftware("v2", 100, "", operator)), types.ErrUnauthorized)
+
+		exec := authz.NewMsgExec(user, []sdk.Msg{updateParams})
+		suite.ErrorIs(validate(&exec), types.ErrUnauthorized)
+		exec = authz.NewMsgExec(user, []sdk.Msg{upgradetypes.NewMsgCancelUpgrade(operator)})
+		suite.ErrorIs(validate(&exec), types.ErrUnauthorized)
+	}
+
+	// the proposals executed by the authority are accepted
+	msg, err := types.NewMsgSubmitProposal([]sdk.Msg{
+		paramstypes.NewMsgUpdateParams(nil, suite.moduleAddr),
+		upgradetypes.NewMsgUpgradeSoftware("v2", 100, "", suite.moduleAddr),
+	}, "title", "description", admin1.String())
+	suite.Require().NoError(err)
+	suite.NoError(validate(msg))
+}
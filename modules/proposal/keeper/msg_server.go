@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the proposal MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypes := make([]string, len(msgs))
+	for i, m := range msgs {
+		msgTypes[i] = sdk.MsgTypeURL(m)
+	}
+
+	proposal, err := m.Keeper.SubmitProposal(ctx, msgs, msg.Title, msg.Description, proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyMessageType, strings.Join(msgTypes, ",")),
+			sdk.NewAttribute(types.AttributeKeyStatus, proposal.Status.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	})
+
+	return &types.MsgSubmitProposalResponse{ProposalId: proposal.Id}, nil
+}
+
+func (m msgServer) ApproveProposal(goCtx context.Context, msg *types.MsgApproveProposal) (*types.MsgApproveProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approver, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := m.Keeper.ApproveProposal(ctx, msg.ProposalId, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(proposal.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyStatus, proposal.Status.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver),
+		),
+	})
+
+	return &types.MsgApproveProposalResponse{}, nil
+}
+
+func (m msgServer) CancelProposal(goCtx context.Context, msg *types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.CancelProposal(ctx, msg.ProposalId, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgCancelProposalResponse{}, nil
+}
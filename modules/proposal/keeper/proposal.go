@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// SubmitProposal creates a proposal of the given messages on behalf of the proposer.
+// The proposal is executed at once if the threshold is met by the proposer approval.
+func (k Keeper) SubmitProposal(
+	ctx sdk.Context, msgs []sdk.Msg, title, description string, proposer sdk.AccAddress,
+) (types.Proposal, error) {
+	if !k.IsAdmin(ctx, proposer) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a proposal admin", proposer)
+	}
+	if err := k.validateMessages(msgs); err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposalID, err := k.GetProposalID(ctx)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	votingEndTime := submitTime.Add(k.GetParams(ctx).VotingPeriod)
+
+	proposal, err := types.NewProposal(proposalID, title, description, msgs, proposer.String(), submitTime, votingEndTime)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	k.SetProposal(ctx, proposal)
+	k.InsertActiveProposalQueue(ctx, proposalID, votingEndTime)
+	k.SetProposalID(ctx, proposalID+1)
+
+	return k.tryExecuteProposal(ctx, proposal), nil
+}
+
+// ApproveProposal adds the approval of the approver to the pending proposal and
+// executes the proposal if the threshold is met
+func (k Keeper) ApproveProposal(ctx sdk.Context, proposalID uint64, approver sdk.AccAddress) (types.Proposal, error) {
+	if !k.IsAdmin(ctx, approver) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a proposal admin", approver)
+	}
+
+	proposal, err := k.getActiveProposal(ctx, proposalID)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+	if proposal.HasApproved(approver.String()) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrAlreadyApproved, "%s has already approved the proposal %d", approver, proposalID)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, approver.String())
+	k.SetProposal(ctx, proposal)
+
+	return k.tryExecuteProposal(ctx, proposal), nil
+}
+
+// CancelProposal cancels the pending proposal. Only the proposer and the root
+// admins are allowed to cancel a proposal.
+func (k Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, operator sdk.AccAddress) error {
+	proposal, err := k.getActiveProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.Proposer != operator.String() && !k.permKeeper.IsAuthorized(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is neither the proposer nor a root admin", operator)
+	}
+
+	k.finalizeProposal(ctx, proposal, types.StatusCancelled, "")
+	return nil
+}
+
+// IsAdmin returns true if the account is allowed to submit and approve proposals
+func (k Keeper) IsAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.permKeeper.HasRole(ctx, address, permtypes.RoleProposalAdmin)
+}
+
+// GetApprovalCount returns the number of approvals of the proposal given by the
+// accounts which are still proposal admins
+func (k Keeper) GetApprovalCount(ctx sdk.Context, proposal types.Proposal) uint32 {
+	var count uint32
+	for _, approval := range proposal.Approvals {
+		approver, err := sdk.AccAddressFromBech32(approval)
+		if err == nil && k.IsAdmin(ctx, approver) {
+			count++
+		}
+	}
+	return count
+}
+
+// ExpireProposals marks as expired the pending proposals whose voting period has ended
+func (k Keeper) ExpireProposals(ctx sdk.Context) {
+	k.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+		k.finalizeProposal(ctx, proposal, types.StatusExpired, "")
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			),
+		)
+		return false
+	})
+}
+
+// tryExecuteProposal executes the messages of the proposal if it has been approved
+// by the threshold of admins. The state changes of the messages are discarded if
+// any of them fails, in which case the proposal is marked as failed.
+func (k Keeper) tryExecuteProposal(ctx sdk.Context, proposal types.Proposal) types.Proposal {
+	if k.GetApprovalCount(ctx, proposal) < k.GetParams(ctx).Threshold {
+		return proposal
+	}
+
+	status, reason := types.StatusExecuted, ""
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.executeMessages(cacheCtx, proposal); err != nil {
+		status, reason = types.StatusFailed, err.Error()
+		k.Logger(ctx).Info("proposal execution failed", "proposal", proposal.Id, "err", reason)
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	proposal = k.finalizeProposal(ctx, proposal, status, reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyStatus, proposal.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyFailure, reason),
+		),
+	)
+	return proposal
+}
+
+func (k Keeper) executeMessages(ctx sdk.Context, proposal types.Proposal) error {
+	msgs, err := proposal.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d (%s)", i, sdk.MsgTypeURL(msg))
+		}
+
+		// emit the events from the executed messages
+		events := make(sdk.Events, len(res.Events))
+		for j, event := range res.Events {
+			events[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+	return nil
+}
+
+// validateMessages checks that the messages are routable and signed by the
+// proposal module account only
+func (k Keeper) validateMessages(msgs []sdk.Msg) error {
+	moduleAddr := k.GetModuleAddress()
+	for i, msg := range msgs {
+		if k.router.Handler(msg) == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidMessage, "message %d: unrecognized message route: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(moduleAddr) {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidMessage, "message %d (%s) must be signed by the %s module account %s only",
+				i, sdk.MsgTypeURL(msg), types.ModuleName, moduleAddr,
+			)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getActiveProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusPending || !ctx.BlockHeader().Time.Before(proposal.VotingEndTime) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+	return proposal, nil
+}
+
+// finalizeProposal sets the final status of the proposal and removes it from the active queue
+func (k Keeper) finalizeProposal(ctx sdk.Context, proposal types.Proposal, status types.ProposalStatus, reason string) types.Proposal {
+	k.RemoveFromActiveProposalQueue(ctx, proposal.Id, proposal.VotingEndTime)
+
+	proposal.Status = status
+	proposal.FailureReason = reason
+	k.SetProposal(ctx, proposal)
+	return proposal
+}
+
+// GetProposal gets the proposal with the given id
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return proposal, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal sets the proposal
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProposalKey(proposal.Id), k.cdc.MustMarshal(&proposal))
+}
+
+// GetProposals returns all the proposals
+func (k Keeper) GetProposals(ctx sdk.Context) []types.Proposal {
+	proposals := make([]types.Proposal, 0)
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return proposals
+}
+
+// IterateProposals iterates through all the proposals
+func (k Keeper) IterateProposals(ctx sdk.Context, op func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+
+		if stop := op(proposal); stop {
+			break
+		}
+	}
+}
+
+// GetProposalID gets the id of the next proposal
+func (k Keeper) GetProposalID(ctx sdk.Context) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ProposalIDKey)
+	if bz == nil {
+		return 0, types.ErrProposalIDNotFound
+	}
+	return types.GetProposalIDFromBytes(bz), nil
+}
+
+// SetProposalID sets the id of the next proposal
+func (k Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalIDKey, types.GetProposalIDBytes(proposalID))
+}
+
+// InsertActiveProposalQueue inserts the proposal into the active proposal queue
+func (k Keeper) InsertActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetActiveProposalQueueKey(proposalID, endTime), types.GetProposalIDBytes(proposalID))
+}
+
+// RemoveFromActiveProposalQueue removes the proposal from the active proposal queue
+func (k Keeper) RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetActiveProposalQueueKey(proposalID, endTime))
+}
+
+// IterateActiveProposalsQueue iterates through the pending proposals whose voting
+// period ends up to the given time
+func (k Keeper) IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, op func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.ActiveProposalQueueKey, sdk.PrefixEndBytes(types.GetActiveProposalByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := types.GetProposalIDFromBytes(iterator.Value())
+		proposal, found := k.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if stop := op(proposal); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// proposal module sentinel errors
+var (
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 2, "unauthorized operation")
+	ErrInvalidProposal    = sdkerrors.Register(ModuleName, 3, "invalid proposal")
+	ErrInvalidMessage     = sdkerrors.Register(ModuleName, 4, "invalid proposal message")
+	ErrUnknownProposal    = sdkerrors.Register(ModuleName, 5, "unknown proposal")
+	ErrInactiveProposal   = sdkerrors.Register(ModuleName, 6, "inactive proposal")
+	ErrAlreadyApproved    = sdkerrors.Register(ModuleName, 7, "proposal already approved")
+	ErrInvalidGenesis     = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
+	ErrInvalidProposalID  = sdkerrors.Register(ModuleName, 9, "invalid proposal id")
+	ErrProposalIDNotFound = sdkerrors.Register(ModuleName, 10, "next proposal id not set")
+)
@@ -0,0 +1,17 @@
+package types
+
+// proposal module event types
+const (
+	EventTypeSubmitProposal  = "submit_proposal"
+	EventTypeApproveProposal = "approve_proposal"
+	EventTypeExecuteProposal = "execute_proposal"
+	EventTypeCancelProposal  = "cancel_proposal"
+	EventTypeExpireProposal  = "expire_proposal"
+
+	AttributeValueCategory  = ModuleName
+	AttributeKeyProposalID  = "proposal_id"
+	AttributeKeyApprovals   = "approvals"
+	AttributeKeyStatus      = "status"
+	AttributeKeyFailure     = "failure_reason"
+	AttributeKeyMessageType = "message_type"
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+}
+
+// PermKeeper defines the expected perm keeper
+type PermKeeper interface {
+	HasRole(ctx sdk.Context, address sdk.AccAddress, role permtypes.Role) bool
+	IsAuthorized(ctx sdk.Context, address sdk.AccAddress, roles ...permtypes.Role) bool
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// DefaultStartingProposalID is the id of the first proposal
+const DefaultStartingProposalID uint64 = 1
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState constructs a new GenesisState instance
+func NewGenesisState(params Params, startingProposalID uint64, proposals []Proposal) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		StartingProposalId: startingProposalID,
+		Proposals:          proposals,
+	}
+}
+
+// DefaultGenesisState gets the default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultStartingProposalID, []Proposal{})
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range data.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateGenesis validates the provided proposal genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.StartingProposalId == 0 {
+		return fmt.Errorf("starting proposal id must be positive")
+	}
+
+	seen := make(map[uint64]bool, len(data.Proposals))
+	for _, p := range data.Proposals {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if p.Id >= data.StartingProposalId {
+			return fmt.Errorf("proposal id %d must be lower than the starting proposal id %d", p.Id, data.StartingProposalId)
+		}
+		if seen[p.Id] {
+			return fmt.Errorf("duplicate proposal: %d", p.Id)
+		}
+		seen[p.Id] = true
+	}
+	return nil
+}
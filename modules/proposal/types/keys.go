@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the proposal module
+	ModuleName = "proposal"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the proposal module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the proposal module
+	RouterKey = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	ProposalKey            = []byte{0x01} // prefix for the proposals
+	ProposalIDKey          = []byte{0x02} // key for the next proposal id
+	ActiveProposalQueueKey = []byte{0x03} // prefix for the pending proposals queue
+)
+
+// GetProposalKey gets the key for the proposal with the given id
+// VALUE: proposal/Proposal
+func GetProposalKey(proposalID uint64) []byte {
+	return append(ProposalKey, GetProposalIDBytes(proposalID)...)
+}
+
+// GetActiveProposalByTimeKey gets the key prefix for the pending proposals
+// ending at the given time
+func GetActiveProposalByTimeKey(endTime time.Time) []byte {
+	return append(ActiveProposalQueueKey, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetActiveProposalQueueKey gets the key for the pending proposal in the queue
+// VALUE: proposal id
+func GetActiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
+	return append(GetActiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// GetProposalIDBytes returns the byte representation of the proposal id
+func GetProposalIDBytes(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposalID)
+	return bz
+}
+
+// GetProposalIDFromBytes returns the proposal id from its byte representation
+func GetProposalIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSubmitProposal  = "submit_proposal"  // type for MsgSubmitProposal
+	TypeMsgApproveProposal = "approve_proposal" // type for MsgApproveProposal
+	TypeMsgCancelProposal  = "cancel_proposal"  // type for MsgCancelProposal
+)
+
+var (
+	_ sdk.Msg = &MsgSubmitProposal{}
+	_ sdk.Msg = &MsgApproveProposal{}
+	_ sdk.Msg = &MsgCancelProposal{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitProposal{}
+)
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance.
+func NewMsgSubmitProposal(msgs []sdk.Msg, title, description, proposer string) (*MsgSubmitProposal, error) {
+	anys, err := PackMessages(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitProposal{
+		Messages:    anys,
+		Title:       title,
+		Description: description,
+		Proposer:    proposer,
+	}, nil
+}
+
+// Route implements Msg.
+func (m MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic implements Msg.
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+	if err := ValidateProposalContent(m.Title, m.Description); err != nil {
+		return err
+	}
+
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+	return ValidateMessages(msgs)
+}
+
+// GetMessages returns the proposed messages.
+func (m MsgSubmitProposal) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMessages(m.Messages)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMessages(unpacker, m.Messages)
+}
+
+// GetSignBytes implements Msg.
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgApproveProposal creates a new MsgApproveProposal instance.
+func NewMsgApproveProposal(proposalID uint64, approver string) *MsgApproveProposal {
+	return &MsgApproveProposal{
+		ProposalId: proposalID,
+		Approver:   approver,
+	}
+}
+
+// Route implements Msg.
+func (m MsgApproveProposal) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgApproveProposal) Type() string { return TypeMsgApproveProposal }
+
+// ValidateBasic implements Msg.
+func (m MsgApproveProposal) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalID, "proposal id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Approver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid approver address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgApproveProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgApproveProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Approver)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(proposalID uint64, operator string) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalId: proposalID,
+		Operator:   operator,
+	}
+}
+
+// Route implements Msg.
+func (m MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (m MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg.
+func (m MsgCancelProposal) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalID, "proposal id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
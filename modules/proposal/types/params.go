@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// proposal params default values
+var (
+	DefaultThreshold    = uint32(1)
+	DefaultVotingPeriod = 7 * 24 * time.Hour // 7 days
+)
+
+// Keys for parameter access
+// nolint
+var (
+	KeyThreshold    = []byte("Threshold")
+	KeyVotingPeriod = []byte("VotingPeriod")
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the proposal module params
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(threshold uint32, votingPeriod time.Duration) Params {
+	return Params{
+		Threshold:    threshold,
+		VotingPeriod: votingPeriod,
+	}
+}
+
+// ParamSetPairs implements paramstypes.ParamSet
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyThreshold, &p.Threshold, validateThreshold),
+		paramstypes.NewParamSetPair(KeyVotingPeriod, &p.VotingPeriod, validateVotingPeriod),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultThreshold, DefaultVotingPeriod)
+}
+
+// String implements stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Threshold:    %d
+  VotingPeriod: %s`, p.Threshold, p.VotingPeriod)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateThreshold(p.Threshold); err != nil {
+		return err
+	}
+	return validateVotingPeriod(p.VotingPeriod)
+}
+
+func validateThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("threshold must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateVotingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", v)
+	}
+	return nil
+}
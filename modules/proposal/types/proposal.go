@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxTitleLength is the maximum length of a proposal title
+	MaxTitleLength = 140
+	// MaxDescriptionLength is the maximum length of a proposal description
+	MaxDescriptionLength = 5000
+)
+
+var _ codectypes.UnpackInterfacesMessage = Proposal{}
+
+// NewProposal constructs a new pending Proposal instance
+func NewProposal(
+	id uint64, title, description string, messages []sdk.Msg, proposer string,
+	submitTime, votingEndTime time.Time,
+) (Proposal, error) {
+	anys, err := PackMessages(messages)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	return Proposal{
+		Id:            id,
+		Title:         title,
+		Description:   description,
+		Messages:      anys,
+		Proposer:      proposer,
+		Approvals:     []string{proposer},
+		Status:        StatusPending,
+		SubmitTime:    submitTime,
+		VotingEndTime: votingEndTime,
+	}, nil
+}
+
+// GetMessages returns the messages of the proposal
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMessages(p.Messages)
+}
+
+// HasApproved returns true if the given address has approved the proposal
+func (p Proposal) HasApproved(address string) bool {
+	for _, approval := range p.Approvals {
+		if approval == address {
+			return true
+		}
+	}
+	return false
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMessages(unpacker, p.Messages)
+}
+
+// Validate performs a stateless validation of the proposal
+func (p Proposal) Validate() error {
+	if p.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalID, "proposal id must be positive")
+	}
+	if err := ValidateProposalContent(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+	for _, approval := range p.Approvals {
+		if _, err := sdk.AccAddressFromBech32(approval); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid approver address (%s)", err)
+		}
+	}
+	if !p.Status.IsValid() || p.Status == StatusNil {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "invalid proposal status: %s", p.Status)
+	}
+
+	msgs, err := p.GetMessages()
+	if err != nil {
+		return err
+	}
+	return ValidateMessages(msgs)
+}
+
+// ValidateProposalContent validates the title and description of a proposal
+func ValidateProposalContent(title, description string) error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "proposal title cannot be blank")
+	}
+	if len(title) > MaxTitleLength {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "proposal title is longer than %d", MaxTitleLength)
+	}
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "proposal description is longer than %d", MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ValidateMessages performs the stateless validation of the proposal messages
+func ValidateMessages(msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMessage, "proposal messages cannot be empty")
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d (%s)", i, sdk.MsgTypeURL(msg))
+		}
+	}
+	return nil
+}
+
+// PackMessages packs the messages into Any's
+func PackMessages(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+// UnpackMessages returns the messages cached in the given Any's
+func UnpackMessages(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidMessage, "message %d is not a sdk.Msg: %s", i, any.TypeUrl)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+func unpackMessages(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsValid returns true if the proposal status is defined
+func (s ProposalStatus) IsValid() bool {
+	_, ok := ProposalStatus_name[int32(s)]
+	return ok
+}
+
+// IsFinal returns true if the proposal cannot change anymore
+func (s ProposalStatus) IsFinal() bool {
+	return s != StatusNil && s != StatusPending
+}
+
+// ProposalStatusFromString parses a proposal status from its name, e.g. "pending"
+// or "PROPOSAL_STATUS_PENDING"
+func ProposalStatusFromString(str string) (ProposalStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(str))
+	if !strings.HasPrefix(name, "PROPOSAL_STATUS_") {
+		name = "PROPOSAL_STATUS_" + name
+	}
+
+	if status, ok := ProposalStatus_value[name]; ok {
+		return ProposalStatus(status), nil
+	}
+	return StatusNil, fmt.Errorf("unknown proposal status: %s", str)
+}
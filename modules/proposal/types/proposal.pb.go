@@ -0,0 +1,1000 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proposal/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProposalStatus defines the status of an admin proposal
+type ProposalStatus int32
+
+const (
+	// PROPOSAL_STATUS_UNSPECIFIED defines the no status
+	StatusNil ProposalStatus = 0
+	// PROPOSAL_STATUS_PENDING defines a proposal waiting for approvals
+	StatusPending ProposalStatus = 1
+	// PROPOSAL_STATUS_EXECUTED defines a proposal whose messages have been executed
+	StatusExecuted ProposalStatus = 2
+	// PROPOSAL_STATUS_FAILED defines an approved proposal whose messages failed to execute
+	StatusFailed ProposalStatus = 3
+	// PROPOSAL_STATUS_CANCELLED defines a proposal cancelled before reaching the threshold
+	StatusCancelled ProposalStatus = 4
+	// PROPOSAL_STATUS_EXPIRED defines a proposal which did not reach the threshold
+	// within the voting period
+	StatusExpired ProposalStatus = 5
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PROPOSAL_STATUS_UNSPECIFIED",
+	1: "PROPOSAL_STATUS_PENDING",
+	2: "PROPOSAL_STATUS_EXECUTED",
+	3: "PROPOSAL_STATUS_FAILED",
+	4: "PROPOSAL_STATUS_CANCELLED",
+	5: "PROPOSAL_STATUS_EXPIRED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PROPOSAL_STATUS_UNSPECIFIED": 0,
+	"PROPOSAL_STATUS_PENDING":     1,
+	"PROPOSAL_STATUS_EXECUTED":    2,
+	"PROPOSAL_STATUS_FAILED":      3,
+	"PROPOSAL_STATUS_CANCELLED":   4,
+	"PROPOSAL_STATUS_EXPIRED":     5,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc394783463638b1, []int{0}
+}
+
+// Proposal defines a set of messages submitted by an admin, executed once
+// approved by the threshold of admins
+type Proposal struct {
+	Id            uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Messages      []*types.Any   `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	Proposer      string         `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvals     []string       `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Status        ProposalStatus `protobuf:"varint,7,opt,name=status,proto3,enum=irita.proposal.ProposalStatus" json:"status,omitempty"`
+	SubmitTime    time.Time      `protobuf:"bytes,8,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	VotingEndTime time.Time      `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	FailureReason string         `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc394783463638b1, []int{0}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+// Params defines the parameters for the proposal module
+type Params struct {
+	// threshold is the number of admin approvals required to execute a proposal
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// voting_period is the duration after which a pending proposal expires
+	VotingPeriod time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc394783463638b1, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("irita.proposal.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*Proposal)(nil), "irita.proposal.Proposal")
+	proto.RegisterType((*Params)(nil), "irita.proposal.Params")
+}
+
+func init() { proto.RegisterFile("proposal/proposal.proto", fileDescriptor_dc394783463638b1) }
+
+var fileDescriptor_dc394783463638b1 = []byte{
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcb, 0x6e, 0xda, 0x4a,
+	0x18, 0xb6, 0x81, 0x70, 0x60, 0x08, 0x84, 0x33, 0x27, 0x3a, 0x71, 0x7c, 0x8e, 0x8c, 0x15, 0xa9,
+	0x12, 0xaa, 0x2a, 0x3b, 0xa5, 0x52, 0x17, 0xdd, 0x11, 0x70, 0x5a, 0x24, 0x44, 0x2c, 0x43, 0xa4,
+	0xa8, 0x1b, 0x34, 0xe0, 0x89, 0x33, 0x95, 0xf1, 0x58, 0x9e, 0x71, 0x94, 0xf4, 0x09, 0x2a, 0x56,
+	0x59, 0x66, 0x83, 0x14, 0xa9, 0x6f, 0xd0, 0x65, 0x9f, 0x20, 0xcb, 0x2c, 0xbb, 0xea, 0x25, 0xd9,
+	0xf4, 0x31, 0x2a, 0x7b, 0x20, 0x17, 0xd2, 0x4d, 0x77, 0x9e, 0xef, 0xf2, 0xcf, 0x37, 0xdf, 0x8c,
+	0x0c, 0x36, 0xc2, 0x88, 0x86, 0x94, 0x21, 0xdf, 0x5c, 0x7c, 0x18, 0x61, 0x44, 0x39, 0x85, 0x15,
+	0x12, 0x11, 0x8e, 0x8c, 0x05, 0xaa, 0xae, 0x7b, 0xd4, 0xa3, 0x29, 0x65, 0x26, 0x5f, 0x42, 0xa5,
+	0x6e, 0x7a, 0x94, 0x7a, 0x3e, 0x36, 0xd3, 0xd5, 0x28, 0x3e, 0x34, 0x51, 0x70, 0x3a, 0xa7, 0xb4,
+	0x65, 0xca, 0x8d, 0x23, 0xc4, 0x09, 0x0d, 0xe6, 0x7c, 0x6d, 0x99, 0xe7, 0x64, 0x82, 0x19, 0x47,
+	0x93, 0x50, 0x08, 0xb6, 0x3e, 0x65, 0x41, 0xc1, 0x9e, 0x6f, 0x0f, 0x2b, 0x20, 0x43, 0x5c, 0x45,
+	0xd6, 0xe5, 0x7a, 0xce, 0xc9, 0x10, 0x17, 0xae, 0x83, 0x15, 0x4e, 0xb8, 0x8f, 0x95, 0x8c, 0x2e,
+	0xd7, 0x8b, 0x8e, 0x58, 0x40, 0x1d, 0x94, 0x5c, 0xcc, 0xc6, 0x11, 0x09, 0x93, 0x8d, 0x94, 0x6c,
+	0xca, 0xdd, 0x87, 0xe0, 0x36, 0x28, 0x4c, 0x30, 0x63, 0xc8, 0xc3, 0x4c, 0xc9, 0xe9, 0xd9, 0x7a,
+	0xa9, 0xb1, 0x6e, 0x88, 0x20, 0xc6, 0x22, 0x88, 0xd1, 0x0c, 0x4e, 0x9d, 0x5b, 0x15, 0x54, 0x41,
+	0x41, 0x94, 0x80, 0x23, 0x65, 0x25, 0x1d, 0x78, 0xbb, 0x86, 0xff, 0x83, 0x22, 0x0a, 0xc3, 0x88,
+	0x1e, 0x23, 0x9f, 0x29, 0x79, 0x3d, 0x5b, 0x2f, 0x3a, 0x77, 0x00, 0x7c, 0x09, 0xf2, 0x8c, 0x23,
+	0x1e, 0x33, 0xe5, 0x2f, 0x5d, 0xae, 0x57, 0x1a, 0x9a, 0xf1, 0xb0, 0x53, 0x63, 0x71, 0xba, 0x7e,
+	0xaa, 0x72, 0xe6, 0x6a, 0x68, 0x81, 0x12, 0x8b, 0x47, 0x13, 0xc2, 0x87, 0x49, 0x25, 0x4a, 0x41,
+	0x97, 0xeb, 0xa5, 0x86, 0xfa, 0x28, 0xe6, 0x60, 0xd1, 0xd7, 0x4e, 0xe1, 0xf2, 0x6b, 0x4d, 0x3a,
+	0xfb, 0x56, 0x93, 0x1d, 0x20, 0x8c, 0x09, 0x05, 0xbb, 0x60, 0xed, 0x98, 0x72, 0x12, 0x78, 0x43,
+	0x1c, 0xb8, 0x62, 0x54, 0xf1, 0x0f, 0x46, 0x95, 0x85, 0xd9, 0x0a, 0xdc, 0x74, 0xda, 0x13, 0x50,
+	0x39, 0x44, 0xc4, 0x8f, 0x23, 0x3c, 0x8c, 0x30, 0x62, 0x34, 0x50, 0x40, 0x5a, 0x46, 0x79, 0x8e,
+	0x3a, 0x29, 0xb8, 0xf5, 0x1e, 0xe4, 0x6d, 0x14, 0xa1, 0x09, 0x4b, 0xba, 0xe1, 0x47, 0x11, 0x66,
+	0x47, 0xd4, 0x17, 0x17, 0x57, 0x76, 0xee, 0x00, 0xf8, 0x06, 0xcc, 0xe7, 0x0f, 0x43, 0x1c, 0x11,
+	0xea, 0xa6, 0xf7, 0x58, 0x6a, 0x6c, 0x3e, 0x8a, 0xd6, 0x9e, 0xbf, 0x1a, 0x91, 0xec, 0x3c, 0x49,
+	0xb6, 0x2a, 0x9c, 0x76, 0x6a, 0x7c, 0x55, 0x38, 0xbf, 0xa8, 0x49, 0x3f, 0x2f, 0x6a, 0xf2, 0xd3,
+	0xcf, 0x19, 0x50, 0x79, 0x58, 0x29, 0x34, 0xc0, 0x7f, 0xb6, 0xb3, 0x67, 0xef, 0xf5, 0x9b, 0xdd,
+	0x61, 0x7f, 0xd0, 0x1c, 0xec, 0xf7, 0x87, 0xfb, 0xbd, 0xbe, 0x6d, 0xb5, 0x3a, 0xbb, 0x1d, 0xab,
+	0x5d, 0x95, 0xd4, 0xf2, 0x74, 0xa6, 0x17, 0x85, 0xb8, 0x47, 0x7c, 0x68, 0x80, 0x8d, 0x65, 0xbd,
+	0x6d, 0xf5, 0xda, 0x9d, 0xde, 0xeb, 0xaa, 0xac, 0xfe, 0x3d, 0x9d, 0xe9, 0x65, 0xa1, 0xb5, 0x71,
+	0xe0, 0x92, 0xc0, 0x83, 0xdb, 0x40, 0x59, 0xd6, 0x5b, 0x07, 0x56, 0x6b, 0x7f, 0x60, 0xb5, 0xab,
+	0x19, 0x15, 0x4e, 0x67, 0x7a, 0x45, 0x18, 0xac, 0x13, 0x3c, 0x8e, 0x39, 0x76, 0xe1, 0x33, 0xf0,
+	0xef, 0xb2, 0x63, 0xb7, 0xd9, 0xe9, 0x5a, 0xed, 0x6a, 0x56, 0xad, 0x4e, 0x67, 0xfa, 0xaa, 0xd0,
+	0xef, 0x22, 0xe2, 0x63, 0x17, 0x36, 0xc0, 0xe6, 0xb2, 0xba, 0xd5, 0xec, 0xb5, 0xac, 0x6e, 0x62,
+	0xc8, 0xa9, 0xff, 0x4c, 0x67, 0xfa, 0x9a, 0x30, 0xb4, 0x50, 0x30, 0xc6, 0x7e, 0xe2, 0xf9, 0xcd,
+	0x19, 0xac, 0x03, 0xbb, 0xe3, 0x58, 0xed, 0xea, 0xca, 0xfd, 0x33, 0x58, 0x27, 0x21, 0x89, 0xb0,
+	0xab, 0xe6, 0x3e, 0x7c, 0xd4, 0xa4, 0x9d, 0xbd, 0xcb, 0x1f, 0x9a, 0x74, 0x79, 0xad, 0xc9, 0x57,
+	0xd7, 0x9a, 0xfc, 0xfd, 0x5a, 0x93, 0xcf, 0x6e, 0x34, 0xe9, 0xea, 0x46, 0x93, 0xbe, 0xdc, 0x68,
+	0xd2, 0xdb, 0xe7, 0x1e, 0xe1, 0x47, 0xf1, 0xc8, 0x18, 0xd3, 0x89, 0x39, 0x22, 0x28, 0x78, 0x47,
+	0x30, 0x22, 0x66, 0xfa, 0x9c, 0xcd, 0x09, 0x75, 0x63, 0x1f, 0xb3, 0xdb, 0x1f, 0x88, 0xc9, 0x4f,
+	0x43, 0xcc, 0x46, 0xf9, 0xf4, 0x0a, 0x5f, 0xfc, 0x1a, 0x00, 0x49, 0x7a, 0x94, 0x49, 0x62, 0x04,
+	0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.VotingPeriod != that1.VotingPeriod {
+		return false
+	}
+	return true
+}
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProposal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintProposal(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.Status != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProposal(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Threshold != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProposal(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovProposal(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovProposal(uint64(m.Threshold))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
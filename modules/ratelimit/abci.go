@@ -0,0 +1,20 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/ratelimit/keeper"
+)
+
+// BeginBlocker deletes the counts of the windows other than the current one, which may
+// also be left by a change of the window params
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	if params.WindowBlocks == 0 {
+		// no window is current once the rate limit is disabled
+		k.PruneCounts(ctx, ^uint64(0))
+		return
+	}
+
+	k.PruneCounts(ctx, params.Window(ctx.BlockHeight()))
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/ratelimit/keeper"
+)
+
+// RateLimitDecorator rate limits the txs and messages of the accounts, the perm module
+// admins being exempted. The limits of the ratelimit module params are enforced in both
+// CheckTx and DeliverTx, while those of the node configuration, if any, only apply to
+// the txs entering the mempool.
+//
+// It must run after the signature verification decorators, so that the txs forged on
+// behalf of an account do not consume its limits.
+type RateLimitDecorator struct {
+	keeper  keeper.Keeper
+	limiter *Limiter
+}
+
+// NewRateLimitDecorator creates a new RateLimitDecorator. The limiter of the node
+// configuration may be nil.
+func NewRateLimitDecorator(k keeper.Keeper, limiter *Limiter) RateLimitDecorator {
+	return RateLimitDecorator{
+		keeper:  k,
+		limiter: limiter,
+	}
+}
+
+// AnteHandle rejects the tx if any of its signers exceeds a limit
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	exempt := func(address sdk.AccAddress) bool {
+		return rld.keeper.IsExempt(ctx, address)
+	}
+
+	// the txs already in the mempool are not counted again when rechecked
+	if rld.limiter != nil && ctx.IsCheckTx() && !ctx.IsReCheckTx() && !simulate {
+		if err := rld.limiter.Consume(ctx, tx, exempt); err != nil {
+			return ctx, err
+		}
+	}
+
+	if err := rld.keeper.ConsumeTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
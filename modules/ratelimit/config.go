@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/bianjieai/irita/modules/ratelimit/types"
+)
+
+// The app.toml options of the `[rate-limit]` section, limiting the txs accepted by the
+// node in its mempool
+const (
+	FlagWindowBlocks     = "rate-limit.window-blocks"
+	FlagMaxTxsPerAccount = "rate-limit.max-txs-per-account"
+	FlagMsgTypeLimits    = "rate-limit.msg-type-limits"
+)
+
+// AddFlags adds the rate limit flags to the start command
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Uint64(FlagWindowBlocks, 0, "Number of blocks of the window of the mempool rate limit, disabled if 0")
+	startCmd.Flags().Uint64(FlagMaxTxsPerAccount, 0, "Maximum number of txs an account may sign per window in the mempool, unlimited if 0")
+	startCmd.Flags().StringSlice(FlagMsgTypeLimits, []string{}, "Comma-separated list of the maximum numbers of messages of a type an account may send per window in the mempool, e.g. /cosmos.bank.v1beta1.MsgSend=10")
+}
+
+// LimiterFromAppOptions creates the limiter of the mempool rate limit configured by the
+// app options, nil if disabled
+func LimiterFromAppOptions(appOpts servertypes.AppOptions) (*Limiter, error) {
+	msgTypeLimits := []types.MsgTypeLimit{}
+	for _, str := range cast.ToStringSlice(appOpts.Get(FlagMsgTypeLimits)) {
+		limit, err := types.ParseMsgTypeLimit(str)
+		if err != nil {
+			return nil, err
+		}
+		msgTypeLimits = append(msgTypeLimits, limit)
+	}
+
+	params := types.NewParams(
+		cast.ToUint64(appOpts.Get(FlagWindowBlocks)),
+		cast.ToUint64(appOpts.Get(FlagMaxTxsPerAccount)),
+		msgTypeLimits,
+	)
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if !params.Enabled() {
+		return nil, nil
+	}
+	return NewLimiter(params), nil
+}
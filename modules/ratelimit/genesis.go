@@ -0,0 +1,22 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/ratelimit/keeper"
+	"github.com/bianjieai/irita/modules/ratelimit/types"
+)
+
+// InitGenesis stores the genesis params
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis outputs the params, the counts of the current window are not exported
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
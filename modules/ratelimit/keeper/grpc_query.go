@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/modules/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the parameters of the ratelimit module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Usage queries the numbers of txs and limited messages sent by the given account within
+// the current window
+func (k Keeper) Usage(c context.Context, req *types.QueryUsageRequest) (*types.QueryUsageResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := permtypes.AccAddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	if params.WindowBlocks == 0 {
+		return &types.QueryUsageResponse{Msgs: []types.MsgTypeCount{}}, nil
+	}
+
+	window := params.Window(ctx.BlockHeight())
+	txs, msgs := k.GetUsage(ctx, window, address)
+	return &types.QueryUsageResponse{
+		WindowStart: int64(window * params.WindowBlocks),
+		Txs:         txs,
+		Msgs:        msgs,
+	}, nil
+}
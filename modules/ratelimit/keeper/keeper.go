@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/modules/ratelimit/types"
+)
+
+var _ types.Counter = Keeper{}
+
+// Keeper defines the ratelimit keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.Codec
+	paramSpace paramstypes.Subspace
+	permKeeper types.PermKeeper
+}
+
+// NewKeeper creates a new ratelimit Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	paramSpace paramstypes.Subspace,
+	permKeeper types.PermKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		permKeeper: permKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// GetParams returns the ratelimit module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the ratelimit module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ConsumeTx counts the tx and its limited messages for their signers within the current
+// window, rejecting the tx if a limit of the params is exceeded
+func (k Keeper) ConsumeTx(ctx sdk.Context, tx sdk.Tx) error {
+	return k.GetParams(ctx).Consume(ctx, k, tx, func(address sdk.AccAddress) bool {
+		return k.IsExempt(ctx, address)
+	})
+}
+
+// IsExempt returns true if the account is not rate limited, as an admin of the perm module
+func (k Keeper) IsExempt(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, role := range k.permKeeper.GetRoles(ctx, address) {
+		if role != permtypes.RoleContractDeployer {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCount returns the number of messages of the given type sent by the account within
+// the window, the number of txs if the type is empty
+func (k Keeper) GetCount(ctx sdk.Context, window uint64, address sdk.AccAddress, msgType string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetCountKey(window, address, msgType))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetCount stores the number of messages of the given type sent by the account within
+// the window, the number of txs if the type is empty
+func (k Keeper) SetCount(ctx sdk.Context, window uint64, address sdk.AccAddress, msgType string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCountKey(window, address, msgType), sdk.Uint64ToBigEndian(count))
+}
+
+// GetUsage returns the numbers of txs and limited messages sent by the account within the window
+func (k Keeper) GetUsage(ctx sdk.Context, window uint64, address sdk.AccAddress) (txs uint64, msgs []types.MsgTypeCount) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAccountCountsPrefix(window, address))
+	defer iterator.Close()
+
+	msgs = make([]types.MsgTypeCount, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		count := sdk.BigEndianToUint64(iterator.Value())
+
+		msgType := types.MsgTypeFromCountKey(iterator.Key(), address)
+		if len(msgType) == 0 {
+			txs = count
+			continue
+		}
+		msgs = append(msgs, types.MsgTypeCount{MsgTypeUrl: msgType, Count: count})
+	}
+	return txs, msgs
+}
+
+// PruneCounts deletes the counts of the windows other than the given one
+func (k Keeper) PruneCounts(ctx sdk.Context, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	collect(store.Iterator(types.CountKey, types.GetWindowCountsPrefix(window)))
+	collect(store.Iterator(sdk.PrefixEndBytes(types.GetWindowCountsPrefix(window)), sdk.PrefixEndBytes(types.CountKey)))
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
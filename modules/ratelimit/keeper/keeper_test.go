@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	"github.com/bianjieai/irita/modules/ratelimit"
+	"github.com/bianjieai/irita/modules/ratelimit/keeper"
+	"github.com/bianjieai/irita/modules/ratelimit/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+var (
+	admin    = testutil.Addr("admin")
+	deployer = testutil.Addr("deployer")
+	sender   = testutil.Addr("sender")
+
+	msgSendType = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	keeper   keeper.Keeper
+	txConfig client.TxConfig
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), nil, types.StoreKey)
+	suite.ctx = env.Ctx.WithBlockHeight(10)
+	suite.txConfig = env.EncodingConfig.TxConfig
+
+	env.PermKeeper.SetRole(suite.ctx, admin, permtypes.RoleComplianceAdmin)
+	env.PermKeeper.SetRole(suite.ctx, deployer, permtypes.RoleContractDeployer)
+
+	suite.keeper = keeper.NewKeeper(env.Cdc, env.Key(types.StoreKey), env.Subspace(types.ModuleName), env.PermKeeper)
+	suite.keeper.SetParams(suite.ctx, types.NewParams(5, 2, []types.MsgTypeLimit{{MsgTypeUrl: msgSendType, MaxMsgs: 3}}))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newTx returns a tx of the given number of msgs sent by the account
+func (suite *KeeperTestSuite) newTx(from sdk.AccAddress, msgs int) sdk.Tx {
+	builder := suite.txConfig.NewTxBuilder()
+	sends := make([]sdk.Msg, msgs)
+	for i := range sends {
+		sends[i] = banktypes.NewMsgSend(from, sender, sdk.NewCoins(sdk.NewInt64Coin("uirita", 1)))
+	}
+	suite.Require().NoError(builder.SetMsgs(sends...))
+	return builder.GetTx()
+}
+
+func (suite *KeeperTestSuite) TestConsumeTx() {
+	suite.NoError(suite.keeper.ConsumeTx(suite.ctx, suite.newTx(sender, 2)))
+
+	// the msg type limit is exceeded and nothing is counted
+	err := suite.keeper.ConsumeTx(suite.ctx, suite.newTx(sender, 2))
+	suite.ErrorIs(err, types.ErrRateLimited)
+
+	suite.NoError(suite.keeper.ConsumeTx(suite.ctx, suite.newTx(sender, 1)))
+	txs, msgs := suite.keeper.GetUsage(suite.ctx, 2, sender)
+	suite.Equal(uint64(2), txs)
+	suite.Equal([]types.MsgTypeCount{{MsgTypeUrl: msgSendType, Count: 3}}, msgs)
+
+	// the tx limit is exceeded by the txs of any messages
+	builder := suite.txConfig.NewTxBuilder()
+	suite.Require().NoError(builder.SetMsgs(banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin("uirita", 1)))},
+		[]banktypes.Output{banktypes.NewOutput(admin, sdk.NewCoins(sdk.NewInt64Coin("uirita", 1)))},
+	)))
+	err = suite.keeper.ConsumeTx(suite.ctx, builder.GetTx())
+	suite.ErrorIs(err, types.ErrRateLimited)
+
+	// the admins are exempted but not the contract deployers
+	for i := 0; i < 3; i++ {
+		suite.NoError(suite.keeper.ConsumeTx(suite.ctx, suite.newTx(admin, 3)))
+	}
+	suite.NoError(suite.keeper.ConsumeTx(suite.ctx, suite.newTx(deployer, 3)))
+	err = suite.keeper.ConsumeTx(suite.ctx, suite.newTx(deployer, 1))
+	suite.ErrorIs(err, types.ErrRateLimited)
+
+	// the counts are reset in the next window
+	ctx := suite.ctx.WithBlockHeight(15)
+	suite.NoError(suite.keeper.ConsumeTx(ctx, suite.newTx(sender, 3)))
+	suite.keeper.PruneCounts(ctx, 3)
+	txs, msgs = suite.keeper.GetUsage(ctx, 2, sender)
+	suite.Zero(txs)
+	suite.Empty(msgs)
+	txs, _ = suite.keeper.GetUsage(ctx, 3, sender)
+	suite.Equal(uint64(1), txs)
+
+	// nothing is counted when disabled
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.NoError(suite.keeper.ConsumeTx(ctx, suite.newTx(sender, 4)))
+	txs, _ = suite.keeper.GetUsage(ctx, 3, sender)
+	suite.Equal(uint64(1), txs)
+}
+
+func (suite *KeeperTestSuite) TestRateLimitDecorator() {
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+	limiter := ratelimit.NewLimiter(types.NewParams(5, 1, nil))
+	decorator := ratelimit.NewRateLimitDecorator(suite.keeper, limiter)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	checkCtx := suite.ctx.WithIsCheckTx(true)
+	_, err := decorator.AnteHandle(checkCtx, suite.newTx(sender, 1), false, next)
+	suite.NoError(err)
+	_, err = decorator.AnteHandle(checkCtx, suite.newTx(sender, 1), false, next)
+	suite.ErrorIs(err, types.ErrRateLimited)
+
+	// the node limits do not apply when rechecking, simulating nor delivering the txs
+	_, err = decorator.AnteHandle(checkCtx.WithIsReCheckTx(true), suite.newTx(sender, 1), false, next)
+	suite.NoError(err)
+	_, err = decorator.AnteHandle(checkCtx, suite.newTx(sender, 1), true, next)
+	suite.NoError(err)
+	_, err = decorator.AnteHandle(suite.ctx, suite.newTx(sender, 1), false, next)
+	suite.NoError(err)
+
+	// the on-chain limits apply in DeliverTx
+	suite.keeper.SetParams(suite.ctx, types.NewParams(5, 1, nil))
+	_, err = decorator.AnteHandle(suite.ctx, suite.newTx(sender, 1), false, next)
+	suite.NoError(err)
+	_, err = decorator.AnteHandle(suite.ctx, suite.newTx(sender, 1), false, next)
+	suite.ErrorIs(err, types.ErrRateLimited)
+}
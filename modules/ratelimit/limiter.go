@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/ratelimit/types"
+)
+
+var _ types.Counter = (*Limiter)(nil)
+
+// Limiter rate limits the txs accepted by the node in CheckTx, with the limits of the
+// node configuration. The counts of the current window are held in memory.
+type Limiter struct {
+	params types.Params
+
+	mtx    sync.Mutex
+	window uint64
+	counts map[string]uint64
+}
+
+// NewLimiter creates a new Limiter with the given limits
+func NewLimiter(params types.Params) *Limiter {
+	return &Limiter{
+		params: params,
+		counts: make(map[string]uint64),
+	}
+}
+
+// Params returns the limits of the limiter
+func (l *Limiter) Params() types.Params {
+	return l.params
+}
+
+// Consume counts the tx and its limited messages for their signers, rejecting the tx if
+// a limit is exceeded
+func (l *Limiter) Consume(ctx sdk.Context, tx sdk.Tx, exempt func(sdk.AccAddress) bool) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.params.Consume(ctx, l, tx, exempt)
+}
+
+// GetCount implements types.Counter
+func (l *Limiter) GetCount(_ sdk.Context, window uint64, address sdk.AccAddress, msgType string) uint64 {
+	if window != l.window {
+		return 0
+	}
+	return l.counts[string(address)+msgType]
+}
+
+// SetCount implements types.Counter, dropping the counts of the previous window
+func (l *Limiter) SetCount(_ sdk.Context, window uint64, address sdk.AccAddress, msgType string, count uint64) {
+	if window != l.window {
+		l.window = window
+		l.counts = make(map[string]uint64)
+	}
+	l.counts[string(address)+msgType] = count
+}
@@ -0,0 +1,18 @@
+package types
+
+// NewGenesisState constructs a new GenesisState instance
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState gets the default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis validates the provided ratelimit genesis state
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the ratelimit module
+	ModuleName = "ratelimit"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the ratelimit module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	CountKey = []byte{0x01} // prefix for the tx and message counts of the accounts
+)
+
+// GetCountKey gets the key for the count of the messages of the given type sent by the
+// account within the window, the count of the txs if the type is empty
+// VALUE: uint64
+func GetCountKey(window uint64, addr sdk.AccAddress, msgType string) []byte {
+	return append(GetAccountCountsPrefix(window, addr), []byte(msgType)...)
+}
+
+// GetAccountCountsPrefix gets the key prefix for the counts of the account within the window
+func GetAccountCountsPrefix(window uint64, addr sdk.AccAddress) []byte {
+	return append(GetWindowCountsPrefix(window), address.MustLengthPrefix(addr)...)
+}
+
+// GetWindowCountsPrefix gets the key prefix for the counts within the window
+func GetWindowCountsPrefix(window uint64) []byte {
+	return append(CountKey, sdk.Uint64ToBigEndian(window)...)
+}
+
+// MsgTypeFromCountKey returns the message type stored in a count key of the account
+func MsgTypeFromCountKey(key []byte, addr sdk.AccAddress) string {
+	// skip the prefix, the window and the length prefixed address
+	return string(key[len(CountKey)+8+1+len(addr):])
+}
+
+// WindowFromCountKey returns the window stored in a count key
+func WindowFromCountKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(CountKey) : len(CountKey)+8])
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Counter stores the numbers of txs and messages sent by the accounts within the windows
+type Counter interface {
+	GetCount(ctx sdk.Context, window uint64, address sdk.AccAddress, msgType string) uint64
+	SetCount(ctx sdk.Context, window uint64, address sdk.AccAddress, msgType string, count uint64)
+}
+
+// Window returns the window of the given block height
+func (p Params) Window(height int64) uint64 {
+	return uint64(height) / p.WindowBlocks
+}
+
+// Consume counts the tx and its limited messages for their signers, rejecting the tx if
+// any of the signers, other than the exempted ones, exceeds a limit. Nothing is counted
+// if the tx is rejected.
+func (p Params) Consume(ctx sdk.Context, counter Counter, tx sdk.Tx, exempt func(sdk.AccAddress) bool) error {
+	if !p.Enabled() {
+		return nil
+	}
+	window := p.Window(ctx.BlockHeight())
+
+	type countKey struct {
+		address string
+		msgType string
+	}
+	var (
+		keys       []countKey
+		increments = make(map[countKey]uint64)
+		exempted   = make(map[string]bool)
+	)
+	add := func(address sdk.AccAddress, msgType string) {
+		key := countKey{address: string(address), msgType: msgType}
+		if _, ok := exempted[key.address]; !ok {
+			exempted[key.address] = exempt(address)
+		}
+		if exempted[key.address] {
+			return
+		}
+		if _, ok := increments[key]; !ok {
+			keys = append(keys, key)
+		}
+		increments[key]++
+	}
+
+	signed := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		msgType := sdk.MsgTypeURL(msg)
+		limited := p.MsgTypeLimit(msgType) > 0
+
+		for _, signer := range msg.GetSigners() {
+			if p.MaxTxsPerAccount > 0 && !signed[string(signer)] {
+				signed[string(signer)] = true
+				add(signer, "")
+			}
+			if limited {
+				add(signer, msgType)
+			}
+		}
+	}
+
+	// check all the limits before counting
+	counts := make([]uint64, len(keys))
+	for i, key := range keys {
+		limit := p.MaxTxsPerAccount
+		if len(key.msgType) > 0 {
+			limit = p.MsgTypeLimit(key.msgType)
+		}
+
+		counts[i] = counter.GetCount(ctx, window, sdk.AccAddress(key.address), key.msgType) + increments[key]
+		if counts[i] <= limit {
+			continue
+		}
+		if len(key.msgType) == 0 {
+			return sdkerrors.Wrapf(
+				ErrRateLimited, "%s may sign at most %d txs per %d blocks",
+				sdk.AccAddress(key.address), limit, p.WindowBlocks,
+			)
+		}
+		return sdkerrors.Wrapf(
+			ErrRateLimited, "%s may send at most %d %s messages per %d blocks",
+			sdk.AccAddress(key.address), limit, key.msgType, p.WindowBlocks,
+		)
+	}
+
+	for i, key := range keys {
+		counter.SetCount(ctx, window, sdk.AccAddress(key.address), key.msgType, counts[i])
+	}
+	return nil
+}
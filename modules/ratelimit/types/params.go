@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keys for parameter access
+// nolint
+var (
+	KeyWindowBlocks     = []byte("WindowBlocks")
+	KeyMaxTxsPerAccount = []byte("MaxTxsPerAccount")
+	KeyMsgTypeLimits    = []byte("MsgTypeLimits")
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the ratelimit module params
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(windowBlocks, maxTxsPerAccount uint64, msgTypeLimits []MsgTypeLimit) Params {
+	return Params{
+		WindowBlocks:     windowBlocks,
+		MaxTxsPerAccount: maxTxsPerAccount,
+		MsgTypeLimits:    msgTypeLimits,
+	}
+}
+
+// ParamSetPairs implements paramstypes.ParamSet
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyWindowBlocks, &p.WindowBlocks, validateWindowBlocks),
+		paramstypes.NewParamSetPair(KeyMaxTxsPerAccount, &p.MaxTxsPerAccount, validateMaxTxsPerAccount),
+		paramstypes.NewParamSetPair(KeyMsgTypeLimits, &p.MsgTypeLimits, validateMsgTypeLimits),
+	}
+}
+
+// DefaultParams returns a default set of parameters, disabling the rate limit
+func DefaultParams() Params {
+	return NewParams(0, 0, []MsgTypeLimit{})
+}
+
+// String implements stringer
+func (p Params) String() string {
+	limits := make([]string, len(p.MsgTypeLimits))
+	for i, l := range p.MsgTypeLimits {
+		limits[i] = fmt.Sprintf("%s=%d", l.MsgTypeUrl, l.MaxMsgs)
+	}
+	return fmt.Sprintf(`Params:
+  WindowBlocks:     %d
+  MaxTxsPerAccount: %d
+  MsgTypeLimits:    [%s]`, p.WindowBlocks, p.MaxTxsPerAccount, strings.Join(limits, ","))
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateWindowBlocks(p.WindowBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxTxsPerAccount(p.MaxTxsPerAccount); err != nil {
+		return err
+	}
+	return validateMsgTypeLimits(p.MsgTypeLimits)
+}
+
+// Enabled returns true if the params limit the txs or the messages
+func (p Params) Enabled() bool {
+	return p.WindowBlocks > 0 && (p.MaxTxsPerAccount > 0 || len(p.MsgTypeLimits) > 0)
+}
+
+// MsgTypeLimit returns the maximum number of messages of the given type per account
+// and window, 0 if unlimited
+func (p Params) MsgTypeLimit(msgType string) uint64 {
+	for _, l := range p.MsgTypeLimits {
+		if l.MsgTypeUrl == msgType {
+			return l.MaxMsgs
+		}
+	}
+	return 0
+}
+
+// ParseMsgTypeLimit parses a message type limit of the form type-url=max-msgs,
+// e.g. /cosmos.bank.v1beta1.MsgSend=10
+func ParseMsgTypeLimit(str string) (MsgTypeLimit, error) {
+	parts := strings.Split(strings.TrimSpace(str), "=")
+	if len(parts) != 2 {
+		return MsgTypeLimit{}, fmt.Errorf("invalid message type limit %s, expected type-url=max-msgs", str)
+	}
+
+	maxMsgs, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return MsgTypeLimit{}, fmt.Errorf("invalid maximum number of messages of %s: %w", str, err)
+	}
+
+	limit := MsgTypeLimit{MsgTypeUrl: parts[0], MaxMsgs: maxMsgs}
+	return limit, validateMsgTypeLimits([]MsgTypeLimit{limit})
+}
+
+func validateWindowBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxTxsPerAccount(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMsgTypeLimits(i interface{}) error {
+	limits, ok := i.([]MsgTypeLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(limits))
+	for _, l := range limits {
+		if !strings.HasPrefix(l.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid message type URL: %s", l.MsgTypeUrl)
+		}
+		if l.MaxMsgs == 0 {
+			return fmt.Errorf("maximum number of messages of %s must be positive", l.MsgTypeUrl)
+		}
+		if seen[l.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message type limit: %s", l.MsgTypeUrl)
+		}
+		seen[l.MsgTypeUrl] = true
+	}
+	return nil
+}
@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ratelimit/ratelimit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgTypeLimit defines the maximum number of messages of a type which an account
+// may send within a window
+type MsgTypeLimit struct {
+	// msg_type_url is the type URL of the messages, e.g. /cosmos.bank.v1beta1.MsgSend
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// max_msgs is the maximum number of messages of the type per account and window
+	MaxMsgs uint64 `protobuf:"varint,2,opt,name=max_msgs,json=maxMsgs,proto3" json:"max_msgs,omitempty" yaml:"max_msgs"`
+}
+
+func (m *MsgTypeLimit) Reset()         { *m = MsgTypeLimit{} }
+func (m *MsgTypeLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeLimit) ProtoMessage()    {}
+func (*MsgTypeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd826bf994ca943, []int{0}
+}
+func (m *MsgTypeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeLimit.Merge(m, src)
+}
+func (m *MsgTypeLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeLimit proto.InternalMessageInfo
+
+// MsgTypeCount defines the number of messages of a type sent by an account
+// within the current window
+type MsgTypeCount struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	Count      uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MsgTypeCount) Reset()         { *m = MsgTypeCount{} }
+func (m *MsgTypeCount) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeCount) ProtoMessage()    {}
+func (*MsgTypeCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd826bf994ca943, []int{1}
+}
+func (m *MsgTypeCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeCount.Merge(m, src)
+}
+func (m *MsgTypeCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeCount proto.InternalMessageInfo
+
+// Params defines the parameters for the ratelimit module
+type Params struct {
+	// window_blocks is the number of blocks of a window, the rate limit is
+	// disabled if 0
+	WindowBlocks uint64 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// max_txs_per_account is the maximum number of txs an account may sign per
+	// window, unlimited if 0
+	MaxTxsPerAccount uint64 `protobuf:"varint,2,opt,name=max_txs_per_account,json=maxTxsPerAccount,proto3" json:"max_txs_per_account,omitempty" yaml:"max_txs_per_account"`
+	// msg_type_limits are the maximum numbers of messages of the given types an
+	// account may send per window
+	MsgTypeLimits []MsgTypeLimit `protobuf:"bytes,3,rep,name=msg_type_limits,json=msgTypeLimits,proto3" json:"msg_type_limits" yaml:"msg_type_limits"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd826bf994ca943, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgTypeLimit)(nil), "irita.ratelimit.MsgTypeLimit")
+	proto.RegisterType((*MsgTypeCount)(nil), "irita.ratelimit.MsgTypeCount")
+	proto.RegisterType((*Params)(nil), "irita.ratelimit.Params")
+}
+
+func init() { proto.RegisterFile("ratelimit/ratelimit.proto", fileDescriptor_6dd826bf994ca943) }
+
+var fileDescriptor_6dd826bf994ca943 = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbf, 0x8f, 0xd3, 0x30,
+	0x14, 0x4e, 0xee, 0xca, 0x71, 0x98, 0x9e, 0x8a, 0xd2, 0x0a, 0xc2, 0x49, 0xd8, 0x95, 0xa7, 0x4e,
+	0x89, 0x74, 0x4c, 0x54, 0x62, 0x20, 0xac, 0x54, 0xaa, 0xa2, 0x63, 0x61, 0x89, 0x9c, 0xd4, 0x0a,
+	0x86, 0xb8, 0x8e, 0xec, 0x44, 0x4d, 0x27, 0x46, 0x56, 0x46, 0xc6, 0xfe, 0x39, 0x1d, 0x3b, 0x32,
+	0x45, 0xd0, 0x2e, 0xcc, 0xf9, 0x0b, 0x50, 0xe2, 0x2a, 0x0d, 0x5d, 0x6f, 0x7b, 0xef, 0x7d, 0xdf,
+	0xfb, 0xde, 0x4f, 0xf0, 0x52, 0x92, 0x8c, 0x26, 0x8c, 0xb3, 0xcc, 0x6d, 0x2d, 0x27, 0x95, 0x22,
+	0x13, 0xd6, 0x80, 0x49, 0x96, 0x11, 0xa7, 0x0d, 0xdf, 0x8e, 0x62, 0x11, 0x8b, 0x06, 0x73, 0x6b,
+	0x4b, 0xd3, 0xf0, 0x37, 0xd0, 0x9f, 0xa9, 0xf8, 0x7e, 0x9d, 0xd2, 0x0f, 0x35, 0xcb, 0x7a, 0x03,
+	0xfa, 0x5c, 0xc5, 0x41, 0xb6, 0x4e, 0x69, 0x90, 0xcb, 0xc4, 0x36, 0xc7, 0xe6, 0xe4, 0x89, 0xf7,
+	0xa2, 0x2a, 0xd1, 0x70, 0x4d, 0x78, 0x32, 0xc5, 0x5d, 0x14, 0xfb, 0x80, 0xeb, 0xe4, 0x8f, 0x32,
+	0xb1, 0x1c, 0x70, 0xcd, 0x49, 0x11, 0x70, 0x15, 0x2b, 0xfb, 0x62, 0x6c, 0x4e, 0x7a, 0xde, 0xb0,
+	0x2a, 0xd1, 0xe0, 0x98, 0x76, 0x44, 0xb0, 0xff, 0x98, 0x93, 0x62, 0xa6, 0x62, 0x35, 0xed, 0xfd,
+	0xdd, 0x20, 0x13, 0x07, 0x6d, 0x03, 0xef, 0x45, 0xbe, 0x7c, 0x50, 0x03, 0x23, 0xf0, 0x28, 0xaa,
+	0x35, 0x74, 0x75, 0x5f, 0x3b, 0xf8, 0xfb, 0x05, 0xb8, 0x9a, 0x13, 0x49, 0xb8, 0xb2, 0xde, 0x82,
+	0x9b, 0x15, 0x5b, 0x2e, 0xc4, 0x2a, 0x08, 0x13, 0x11, 0x7d, 0x55, 0x8d, 0x78, 0xcf, 0xb3, 0xab,
+	0x12, 0x8d, 0xb4, 0xf8, 0x7f, 0x30, 0xf6, 0xfb, 0xda, 0xf7, 0x1a, 0xd7, 0x9a, 0x81, 0x61, 0x3d,
+	0x46, 0x56, 0xa8, 0x20, 0xa5, 0x32, 0x20, 0x51, 0xa7, 0x9a, 0x07, 0xab, 0x12, 0xdd, 0x9e, 0x66,
+	0x3d, 0x23, 0x61, 0xff, 0x19, 0x27, 0xc5, 0x7d, 0xa1, 0xe6, 0x54, 0xbe, 0xd3, 0x21, 0x8b, 0x82,
+	0x41, 0x3b, 0x4b, 0x73, 0x22, 0x65, 0x5f, 0x8e, 0x2f, 0x27, 0x4f, 0xef, 0x5e, 0x39, 0x67, 0xb7,
+	0x73, 0xba, 0x27, 0xf2, 0xe0, 0xb6, 0x44, 0x46, 0x55, 0xa2, 0xe7, 0x67, 0xfb, 0xd0, 0x1a, 0xd8,
+	0xbf, 0xe1, 0x1d, 0xb6, 0x9a, 0x5e, 0xff, 0xdc, 0x20, 0xa3, 0x5e, 0xb5, 0x37, 0xdf, 0xfe, 0x81,
+	0xc6, 0x76, 0x0f, 0xcd, 0xdd, 0x1e, 0x9a, 0xbf, 0xf7, 0xd0, 0xfc, 0x71, 0x80, 0xc6, 0xee, 0x00,
+	0x8d, 0x5f, 0x07, 0x68, 0x7c, 0xba, 0x8b, 0x59, 0xf6, 0x39, 0x0f, 0x9d, 0x48, 0x70, 0x37, 0x64,
+	0x64, 0xf9, 0x85, 0x51, 0xc2, 0xdc, 0xa6, 0x13, 0x97, 0x8b, 0x45, 0x9e, 0x50, 0x75, 0x7a, 0x32,
+	0xb7, 0xae, 0xa8, 0xc2, 0xab, 0xe6, 0x89, 0x5e, 0xff, 0x1b, 0x00, 0x98, 0x65, 0x57, 0x24, 0x88,
+	0x02, 0x00, 0x00,
+}
+
+func (this *MsgTypeLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTypeLimit)
+	if !ok {
+		that2, ok := that.(MsgTypeLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.MaxMsgs != that1.MaxMsgs {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	if this.MaxTxsPerAccount != that1.MaxTxsPerAccount {
+		return false
+	}
+	if len(this.MsgTypeLimits) != len(that1.MsgTypeLimits) {
+		return false
+	}
+	for i := range this.MsgTypeLimits {
+		if !this.MsgTypeLimits[i].Equal(&that1.MsgTypeLimits[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *MsgTypeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMsgs != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.MaxMsgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeLimits) > 0 {
+		for iNdEx := len(m.MsgTypeLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatelimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxTxsPerAccount != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.MaxTxsPerAccount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRatelimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRatelimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTypeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.MaxMsgs != 0 {
+		n += 1 + sovRatelimit(uint64(m.MaxMsgs))
+	}
+	return n
+}
+
+func (m *MsgTypeCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRatelimit(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovRatelimit(uint64(m.WindowBlocks))
+	}
+	if m.MaxTxsPerAccount != 0 {
+		n += 1 + sovRatelimit(uint64(m.MaxTxsPerAccount))
+	}
+	if len(m.MsgTypeLimits) > 0 {
+		for _, e := range m.MsgTypeLimits {
+			l = e.Size()
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRatelimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRatelimit(x uint64) (n int) {
+	return sovRatelimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTypeLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			m.MaxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerAccount", wireType)
+			}
+			m.MaxTxsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeLimits = append(m.MsgTypeLimits, MsgTypeLimit{})
+			if err := m.MsgTypeLimits[len(m.MsgTypeLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRatelimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRatelimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRatelimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRatelimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRatelimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRatelimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRatelimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,13 @@
+package sm2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/sm2/keeper"
+)
+
+// BeginBlocker reads the hash algorithm of the EVM txs of the block from the params, which
+// then apply to the whole block even if updated by one of its txs
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.SetBlockEvmTxHashAlgo(k.GetEvmTxHashAlgo(ctx))
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	evmcrypto "github.com/bianjieai/irita/modules/evm/crypto"
+	"github.com/bianjieai/irita/modules/sm2/types"
+)
+
+// Keeper defines the sm2 keeper
+type Keeper struct {
+	paramSpace paramstypes.Subspace
+	// blockHashAlgo is the hash algorithm of the EVM txs of the block being delivered, read
+	// from the params at its beginning
+	blockHashAlgo *evmcrypto.HashAlgo
+}
+
+// NewKeeper creates a new sm2 Keeper instance
+func NewKeeper(paramSpace paramstypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:    paramSpace,
+		blockHashAlgo: new(evmcrypto.HashAlgo),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// GetParams returns the sm2 module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the sm2 module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetEvmTxHashAlgo returns the hash algorithm of the EVM txs signed with SM2 keys in the
+// params, Keccak-256 if not set, as before the module is added by an upgrade
+func (k Keeper) GetEvmTxHashAlgo(ctx sdk.Context) evmcrypto.HashAlgo {
+	var algo string
+	k.paramSpace.GetIfExists(ctx, types.KeyEvmTxHashAlgo, &algo)
+	if algo == "" {
+		return evmcrypto.HashKeccak256
+	}
+	return evmcrypto.HashAlgo(algo)
+}
+
+// SetBlockEvmTxHashAlgo sets the hash algorithm of the EVM txs of the block being delivered
+func (k Keeper) SetBlockEvmTxHashAlgo(algo evmcrypto.HashAlgo) {
+	*k.blockHashAlgo = algo
+}
+
+// EvmTxHashAlgo returns the hash algorithm of the EVM txs signed with SM2 keys. The params
+// updated within a block apply from the next one: the txs delivered in a block are hashed
+// with the algorithm of the params at its beginning, and the txs checked for the mempool
+// with that of the committed params.
+func (k Keeper) EvmTxHashAlgo(ctx sdk.Context) evmcrypto.HashAlgo {
+	if ctx.IsCheckTx() || *k.blockHashAlgo == "" {
+		return k.GetEvmTxHashAlgo(ctx)
+	}
+	return *k.blockHashAlgo
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	evmcrypto "github.com/bianjieai/irita/modules/evm/crypto"
+	"github.com/bianjieai/irita/modules/sm2"
+	"github.com/bianjieai/irita/modules/sm2/keeper"
+	"github.com/bianjieai/irita/modules/sm2/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	paramSpace paramstypes.Subspace
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), nil)
+	suite.ctx = env.Ctx
+
+	suite.paramSpace = env.Subspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())
+	suite.keeper = keeper.NewKeeper(suite.paramSpace)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestEvmTxHashAlgo() {
+	// Keccak-256 until the module is added
+	suite.Require().Equal(evmcrypto.HashKeccak256, suite.keeper.EvmTxHashAlgo(suite.ctx))
+
+	sm2.InitGenesis(suite.ctx, suite.keeper, *types.DefaultGenesisState())
+	sm2.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(evmcrypto.HashKeccak256, suite.keeper.EvmTxHashAlgo(suite.ctx))
+
+	// updated through the params module, the algorithm applies to the delivered txs from the next block
+	suite.Require().NoError(suite.paramSpace.Update(suite.ctx, types.KeyEvmTxHashAlgo, []byte(`"sm3"`)))
+	suite.Require().Equal(evmcrypto.HashKeccak256, suite.keeper.EvmTxHashAlgo(suite.ctx))
+	suite.Require().Equal(evmcrypto.HashSM3, suite.keeper.EvmTxHashAlgo(suite.ctx.WithIsCheckTx(true)))
+
+	sm2.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(evmcrypto.HashSM3, suite.keeper.EvmTxHashAlgo(suite.ctx))
+	suite.Require().Equal(types.NewParams(evmcrypto.HashSM3), sm2.ExportGenesis(suite.ctx, suite.keeper).Params)
+
+	suite.Require().Error(suite.paramSpace.Update(suite.ctx, types.KeyEvmTxHashAlgo, []byte(`"sha256"`)))
+}
+
+func (suite *KeeperTestSuite) TestValidateGenesis() {
+	suite.Require().NoError(types.ValidateGenesis(*types.DefaultGenesisState()))
+	suite.Require().NoError(types.ValidateGenesis(*types.NewGenesisState(types.NewParams(evmcrypto.HashSM3))))
+	suite.Require().Error(types.ValidateGenesis(types.GenesisState{}))
+}
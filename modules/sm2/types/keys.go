@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the name of the sm2 module
+	ModuleName = "sm2"
+
+	// QuerierRoute is the querier route for the sm2 module
+	QuerierRoute = ModuleName
+)
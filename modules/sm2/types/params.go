@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	evmcrypto "github.com/bianjieai/irita/modules/evm/crypto"
+)
+
+// Keys for parameter access
+// nolint
+var (
+	KeyEvmTxHashAlgo = []byte("EvmTxHashAlgo")
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the sm2 module params
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(evmTxHashAlgo evmcrypto.HashAlgo) Params {
+	return Params{
+		EvmTxHashAlgo: string(evmTxHashAlgo),
+	}
+}
+
+// ParamSetPairs implements paramstypes.ParamSet
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyEvmTxHashAlgo, &p.EvmTxHashAlgo, validateEvmTxHashAlgo),
+	}
+}
+
+// DefaultParams returns a default set of parameters, hashing the EVM txs with Keccak-256
+// as the go-ethereum signers
+func DefaultParams() Params {
+	return NewParams(evmcrypto.HashKeccak256)
+}
+
+// String implements stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  EvmTxHashAlgo: %s`, p.EvmTxHashAlgo)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	return validateEvmTxHashAlgo(p.EvmTxHashAlgo)
+}
+
+func validateEvmTxHashAlgo(i interface{}) error {
+	algo, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return evmcrypto.HashAlgo(algo).Validate()
+}
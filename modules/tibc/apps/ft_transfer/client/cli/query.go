@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+// GetQueryCmd returns the query commands for the TIBC fungible token transfer
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "tibc-ft-transfer",
+		Short:                      "TIBC fungible token transfer query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryDenomTrace(),
+		GetCmdQueryDenomTraces(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryDenomTrace defines the command to query a denom trace from a given hash.
+func GetCmdQueryDenomTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-trace [hash]",
+		Short:   "Query the denom trace info from a given trace hash",
+		Example: fmt.Sprintf("%s query tibc-ft-transfer denom-trace <hash>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomTrace(cmd.Context(), &types.QueryDenomTraceRequest{
+				Hash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomTraces defines the command to query all the denom traces
+// that this chain maintains.
+func GetCmdQueryDenomTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces",
+		Short:   "Query the trace info for all the vouchers",
+		Example: fmt.Sprintf("%s query tibc-ft-transfer denom-traces", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomTraces(cmd.Context(), &types.QueryDenomTracesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom traces")
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+const (
+	FlagRelayChain = "relay-chain"
+)
+
+// NewTxCmd returns the transaction commands for the TIBC fungible token transfer
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "tibc-ft-transfer",
+		Short:                      "TIBC fungible token transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTransferTxCmd(),
+	)
+
+	return txCmd
+}
+
+// NewTransferTxCmd returns the command to create a MsgFtTransfer transaction
+func NewTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [dest-chain] [receiver] [amount]",
+		Short: "Transfer fungible tokens through TIBC",
+		Example: fmt.Sprintf(
+			"%s tx tibc-ft-transfer transfer <dest-chain-name> <receiver> <amount> "+
+				"--relay-chain=<relay-chain-name>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			token, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			relayChain, err := cmd.Flags().GetString(FlagRelayChain)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFtTransfer(
+				token, clientCtx.GetFromAddress().String(),
+				args[1], args[0], relayChain,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRelayChain, "", "relay chain used by the cross-chain transfer")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
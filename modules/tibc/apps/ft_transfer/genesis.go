@@ -0,0 +1,24 @@
+package ft_transfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/keeper"
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+// InitGenesis stores the genesis denom traces
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err.Error())
+	}
+
+	for _, trace := range data.DenomTraces {
+		k.SetDenomTrace(ctx, trace)
+	}
+}
+
+// ExportGenesis outputs the denom traces
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllDenomTraces(ctx))
+}
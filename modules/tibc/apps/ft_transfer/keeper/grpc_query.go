@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DenomTrace implements the Query/DenomTrace gRPC method
+func (k Keeper) DenomTrace(c context.Context, req *types.QueryDenomTraceRequest) (*types.QueryDenomTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, req.Hash).Error(),
+		)
+	}
+
+	return &types.QueryDenomTraceResponse{
+		DenomTrace: &denomTrace,
+	}, nil
+}
+
+// DenomTraces implements the Query/DenomTraces gRPC method
+func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesRequest) (*types.QueryDenomTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var denomTrace types.DenomTrace
+		if err := k.cdc.Unmarshal(value, &denomTrace); err != nil {
+			return err
+		}
+
+		traces = append(traces, denomTrace)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomTracesResponse{
+		DenomTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
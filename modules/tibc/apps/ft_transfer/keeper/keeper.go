@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/bianjieai/tibc-go/modules/tibc/core/24-host"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+// Keeper defines the TIBC fungible token transfer keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	ak types.AccountKeeper
+	bk types.BankKeeper
+	tk types.TokenKeeper
+	pk types.PacketKeeper
+	ck types.ClientKeeper
+}
+
+// NewKeeper creates a new TIBC fungible token transfer Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key sdk.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	tk types.TokenKeeper,
+	pk types.PacketKeeper,
+	ck types.ClientKeeper,
+) Keeper {
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the TIBC ft-transfer module account has not been set")
+	}
+
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+		ak:       ak,
+		bk:       bk,
+		tk:       tk,
+		pk:       pk,
+		ck:       ck,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetEscrowAddress returns the address escrowing the tokens sent away from
+// their origin to the given chain
+func (k Keeper) GetEscrowAddress(chain string) sdk.AccAddress {
+	return types.GetEscrowAddress(chain)
+}
+
+// HasDenomTrace checks if a the key with the given denom trace hash exists on the store.
+func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	return store.Has(denomTraceHash)
+}
+
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	store.Set(denomTrace.Hash(), k.cdc.MustMarshal(&denomTrace))
+}
+
+// GetDenomTrace retrieves the full identifiers trace and base denom from the store.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+
+	bz := store.Get(denomTraceHash)
+	if bz == nil {
+		return types.DenomTrace{}, false
+	}
+
+	var denomTrace types.DenomTrace
+	k.cdc.MustUnmarshal(bz, &denomTrace)
+	return denomTrace, true
+}
+
+// GetAllDenomTraces returns all the denom traces
+func (k Keeper) GetAllDenomTraces(ctx sdk.Context) types.Traces {
+	traces := types.Traces{}
+	k.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		traces = append(traces, denomTrace)
+		return false
+	})
+	return traces.Sort()
+}
+
+// IterateDenomTraces iterates through all the denom traces
+func (k Keeper) IterateDenomTraces(ctx sdk.Context, op func(denomTrace types.DenomTrace) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomTraceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var denomTrace types.DenomTrace
+		k.cdc.MustUnmarshal(iterator.Value(), &denomTrace)
+
+		if stop := op(denomTrace); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	packettypes "github.com/bianjieai/tibc-go/modules/tibc/core/04-packet/types"
+	"github.com/bianjieai/tibc-go/modules/tibc/core/exported"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/keeper"
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+	"github.com/bianjieai/irita/testutil"
+)
+
+const (
+	chainA     = "A"
+	chainB     = "B"
+	chainC     = "C"
+	tokenDenom = "uirita"
+)
+
+var (
+	sender   = testutil.Addr("sender")
+	receiver = testutil.Addr("receiver")
+)
+
+// tokenKeeper holds the token of the token denom
+type tokenKeeper struct{}
+
+func (tokenKeeper) GetToken(_ sdk.Context, denom string) (tokentypes.TokenI, error) {
+	if denom != tokenDenom {
+		return nil, tokentypes.ErrTokenNotExists
+	}
+	return &tokentypes.Token{Symbol: "irita", Name: "Irita token", MinUnit: tokenDenom, Scale: 6}, nil
+}
+
+// packetKeeper records the sent packets
+type packetKeeper struct {
+	packets []exported.PacketI
+}
+
+func (k *packetKeeper) GetNextSequenceSend(sdk.Context, string, string) uint64 {
+	return uint64(len(k.packets) + 1)
+}
+
+func (k *packetKeeper) SendPacket(_ sdk.Context, packet exported.PacketI) error {
+	k.packets = append(k.packets, packet)
+	return nil
+}
+
+// clientKeeper names the local chain A
+type clientKeeper struct{}
+
+func (clientKeeper) GetChainName(sdk.Context) string {
+	return chainA
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	bankKeeper   bankkeeper.Keeper
+	packetKeeper *packetKeeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	env := testutil.NewKeeperEnv(suite.T(), map[string][]string{types.ModuleName: {authtypes.Minter, authtypes.Burner}}, types.StoreKey)
+	suite.ctx = env.Ctx
+	suite.bankKeeper = env.BankKeeper
+	suite.packetKeeper = &packetKeeper{}
+
+	suite.keeper = keeper.NewKeeper(
+		env.Cdc, env.Key(types.StoreKey), env.AccountKeeper, env.BankKeeper, tokenKeeper{}, suite.packetKeeper, clientKeeper{},
+	)
+
+	env.Fund(suite.T(), sender, sdk.NewInt64Coin(tokenDenom, 1000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// lastPacket returns the last sent packet and its data
+func (suite *KeeperTestSuite) lastPacket() (packettypes.Packet, types.FungibleTokenPacketData) {
+	suite.Require().NotEmpty(suite.packetKeeper.packets)
+	packet := suite.packetKeeper.packets[len(suite.packetKeeper.packets)-1].(packettypes.Packet)
+
+	var data types.FungibleTokenPacketData
+	suite.Require().NoError(data.Unmarshal(packet.GetData()))
+	return packet, data
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress, denom string) int64 {
+	return suite.bankKeeper.GetBalance(suite.ctx, addr, denom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) recv(sourceChain, denom string, amount int64, awayFromOrigin bool) error {
+	data := types.NewFungibleTokenPacketData(
+		denom, sdk.NewInt(amount).String(), testutil.Addr("remote").String(), receiver.String(), awayFromOrigin,
+	)
+	packet := packettypes.NewPacket(data.GetBytes(), 1, sourceChain, chainA, "", string(types.PortID))
+	return suite.keeper.OnRecvPacket(suite.ctx, packet, data)
+}
+
+func (suite *KeeperTestSuite) TestEscrowAndUnescrow() {
+	err := suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(tokenDenom, 300), sender, receiver.String(), chainB, "")
+	suite.Require().NoError(err)
+	err = suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(tokenDenom, 200), sender, receiver.String(), chainC, "")
+	suite.Require().NoError(err)
+
+	_, data := suite.lastPacket()
+	suite.Require().Equal(tokenDenom, data.Denom)
+	suite.Require().True(data.AwayFromOrigin)
+
+	suite.Require().Equal(int64(500), suite.balance(sender, tokenDenom))
+	suite.Require().Equal(int64(300), suite.balance(suite.keeper.GetEscrowAddress(chainB), tokenDenom))
+	suite.Require().Equal(int64(200), suite.balance(suite.keeper.GetEscrowAddress(chainC), tokenDenom))
+
+	// B can only unescrow the tokens sent to B
+	suite.Require().Error(suite.recv(chainB, "ft/A/B/"+tokenDenom, 400, false))
+	suite.Require().NoError(suite.recv(chainB, "ft/A/B/"+tokenDenom, 300, false))
+
+	suite.Require().Equal(int64(300), suite.balance(receiver, tokenDenom))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, suite.keeper.GetEscrowAddress(chainB)).IsZero())
+	suite.Require().Equal(int64(200), suite.balance(suite.keeper.GetEscrowAddress(chainC), tokenDenom))
+}
+
+func (suite *KeeperTestSuite) TestMintAndBurn() {
+	suite.Require().NoError(suite.recv(chainB, "ubtc", 100, true))
+
+	voucher := types.ParseDenomTrace("ft/B/A/ubtc").IBCDenom()
+	suite.Require().Equal(int64(100), suite.balance(receiver, voucher))
+
+	path, err := suite.keeper.DenomPathFromHash(suite.ctx, voucher)
+	suite.Require().NoError(err)
+	suite.Require().Equal("ft/B/A/ubtc", path)
+
+	// the vouchers moving back to B are burnt
+	err = suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(voucher, 40), receiver, sender.String(), chainB, "")
+	suite.Require().NoError(err)
+
+	_, data := suite.lastPacket()
+	suite.Require().Equal("ft/B/A/ubtc", data.Denom)
+	suite.Require().False(data.AwayFromOrigin)
+	suite.Require().Equal(int64(60), suite.bankKeeper.GetSupply(suite.ctx, voucher).Amount.Int64())
+
+	// the vouchers moving on to C are escrowed
+	err = suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(voucher, 10), receiver, sender.String(), chainC, "")
+	suite.Require().NoError(err)
+
+	_, data = suite.lastPacket()
+	suite.Require().True(data.AwayFromOrigin)
+	suite.Require().Equal(int64(10), suite.balance(suite.keeper.GetEscrowAddress(chainC), voucher))
+}
+
+func (suite *KeeperTestSuite) TestRefund() {
+	errAck := packettypes.NewErrorAcknowledgement("failed")
+
+	// the escrowed tokens are unescrowed
+	err := suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(tokenDenom, 300), sender, receiver.String(), chainB, "")
+	suite.Require().NoError(err)
+
+	packet, data := suite.lastPacket()
+	suite.Require().NoError(suite.keeper.OnAcknowledgementPacket(suite.ctx, packet, data, errAck))
+	suite.Require().Equal(int64(1000), suite.balance(sender, tokenDenom))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, suite.keeper.GetEscrowAddress(chainB)).IsZero())
+
+	// the burnt vouchers are minted back
+	suite.Require().NoError(suite.recv(chainB, "ubtc", 100, true))
+	voucher := types.ParseDenomTrace("ft/B/A/ubtc").IBCDenom()
+
+	err = suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(voucher, 100), receiver, sender.String(), chainB, "")
+	suite.Require().NoError(err)
+	suite.Require().True(suite.bankKeeper.GetSupply(suite.ctx, voucher).IsZero())
+
+	packet, data = suite.lastPacket()
+	suite.Require().NoError(suite.keeper.OnAcknowledgementPacket(suite.ctx, packet, data, errAck))
+	suite.Require().Equal(int64(100), suite.balance(receiver, voucher))
+
+	// nothing is refunded on success
+	suite.Require().NoError(suite.keeper.OnAcknowledgementPacket(
+		suite.ctx, packet, data, packettypes.NewResultAcknowledgement([]byte{byte(1)}),
+	))
+	suite.Require().Equal(int64(100), suite.balance(receiver, voucher))
+}
+
+func (suite *KeeperTestSuite) TestRecvForgedPacket() {
+	err := suite.keeper.SendFtTransfer(suite.ctx, sdk.NewInt64Coin(tokenDenom, 300), sender, receiver.String(), chainB, "")
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg            string
+		sourceChain    string
+		denom          string
+		awayFromOrigin bool
+	}{
+		{"tokens sent to B claimed back by C", chainC, "ft/A/B/" + tokenDenom, false},
+		{"unprefixed denom claimed back", chainB, tokenDenom, false},
+		{"tokens moving back flagged as away", chainB, "ft/A/B/" + tokenDenom, true},
+		{"vouchers moving away flagged as back", chainB, "ft/C/B/ubtc", false},
+		{"vouchers not held by the source chain", chainC, "ft/C/B/ubtc", true},
+	}
+
+	for _, tc := range testCases {
+		err := suite.recv(tc.sourceChain, tc.denom, 100, tc.awayFromOrigin)
+		suite.Require().ErrorIs(err, types.ErrInvalidPacketPath, tc.msg)
+	}
+
+	suite.Require().Equal(int64(300), suite.balance(suite.keeper.GetEscrowAddress(chainB), tokenDenom))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, receiver).IsZero())
+	suite.Require().Empty(suite.keeper.GetAllDenomTraces(suite.ctx))
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// FtTransfer implements the Msg/FtTransfer interface
+func (k Keeper) FtTransfer(goCtx context.Context, msg *types.MsgFtTransfer) (*types.MsgFtTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SendFtTransfer(
+		ctx, msg.Token, sender, msg.Receiver,
+		msg.DestChain, msg.RelayChain,
+	); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("TIBC fungible token transfer", "token", msg.Token.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFtTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyDestChain, msg.DestChain),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Token.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgFtTransferResponse{}, nil
+}
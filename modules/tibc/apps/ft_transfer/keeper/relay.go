@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	packettypes "github.com/bianjieai/tibc-go/modules/tibc/core/04-packet/types"
+	coretypes "github.com/bianjieai/tibc-go/modules/tibc/core/types"
+
+	"github.com/bianjieai/irita/modules/tibc/apps/ft_transfer/types"
+)
+
+const (
+	// DELIMITER is the separator of the denom path
+	DELIMITER = "/"
+)
+
+// SendFtTransfer sends the fungible tokens to the destination chain. The tokens
+// moving away from their origin are escrowed in the escrow address of the
+// destination chain, while the vouchers moving back to their origin are burnt.
+func (k Keeper) SendFtTransfer(
+	ctx sdk.Context,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	destChain string,
+	relayChain string,
+) error {
+	sourceChain := k.ck.GetChainName(ctx)
+	if sourceChain == destChain {
+		return sdkerrors.Wrapf(types.ErrScChainEqualToDestChain, "invalid destChain %s equals to scChain %s", destChain, sourceChain)
+	}
+
+	fullDenomPath, err := k.fullDenomPath(ctx, token.Denom)
+	if err != nil {
+		return err
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelSourceChain, sourceChain),
+		telemetry.NewLabel(coretypes.LabelDestinationChain, destChain),
+	}
+
+	// determine whether the token is sent from the source chain or sent back to the source chain from other chains
+	awayFromOrigin := determineAwayFromOrigin(fullDenomPath, destChain)
+	labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, strconv.FormatBool(awayFromOrigin)))
+
+	coins := sdk.NewCoins(token)
+	if awayFromOrigin {
+		// escrow the tokens moving away from their origin
+		if err := k.bk.SendCoins(ctx, sender, k.GetEscrowAddress(destChain), coins); err != nil {
+			return err
+		}
+	} else {
+		// burn the vouchers moving back to their origin
+		if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bk.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	packetData := types.NewFungibleTokenPacketData(
+		fullDenomPath,
+		token.Amount.String(),
+		sender.String(),
+		receiver,
+		awayFromOrigin,
+	)
+
+	sequence := k.pk.GetNextSequenceSend(ctx, sourceChain, destChain)
+	packet := packettypes.NewPacket(packetData.GetBytes(), sequence, sourceChain, destChain, relayChain, string(types.PortID))
+
+	defer func() {
+		telemetry.SetGaugeWithLabels(
+			[]string{"tx", "msg", "tibc", "fttransfer"},
+			float32(token.Amount.Int64()),
+			[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
+		)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"tibc", types.ModuleName, "send"},
+			1,
+			labels,
+		)
+	}()
+
+	return k.pk.SendPacket(ctx, packet)
+}
+
+// OnRecvPacket mints the vouchers for the tokens moving away from their origin
+// and unescrows the tokens moving back to their origin:
+//
+//	A->B->C  away_from_origin == true
+//	  B receive packet from A : denom -> ft/A/B/denom
+//	  C receive packet from B : ft/A/B/denom -> ft/A/B/C/denom
+//	C->B->A  away_from_origin == false
+//	  B receive packet from C : ft/A/B/C/denom -> ft/A/B/denom
+//	  A receive packet from B : ft/A/B/denom -> denom
+//
+// The direction is recomputed from the denom path rather than trusted from the
+// packet, and the last hop of a prefixed path must be the packet source chain.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet packettypes.Packet, data types.FungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := validatePacketPath(packet, data); err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	amount, err := data.ParseAmount()
+	if err != nil {
+		return err
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelSourceChain, packet.SourceChain),
+		telemetry.NewLabel(coretypes.LabelDestinationChain, packet.DestinationChain),
+	}
+
+	// the receipt is rolled back as a whole if any bank operation fails
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var newDenomPath string
+	if data.AwayFromOrigin {
+		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
+
+		// mint the vouchers
+		newDenomPath = getAwayNewDenomPath(packet.SourceChain, packet.DestinationChain, data.Denom)
+		voucher := sdk.NewCoins(sdk.NewCoin(k.getIBCDenomFromDenomPath(cacheCtx, newDenomPath), amount))
+
+		if err := k.bk.MintCoins(cacheCtx, types.ModuleName, voucher); err != nil {
+			return err
+		}
+		if err := k.bk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, voucher); err != nil {
+			return err
+		}
+	} else {
+		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
+
+		// unescrow the tokens sent to the source chain
+		newDenomPath = getBackNewDenomPath(data.Denom)
+		token := sdk.NewCoins(sdk.NewCoin(types.ParseDenomTrace(newDenomPath).IBCDenom(), amount))
+
+		if err := k.bk.SendCoins(cacheCtx, k.GetEscrowAddress(packet.SourceChain), receiver, token); err != nil {
+			return err
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	defer func() {
+		telemetry.SetGaugeWithLabels(
+			[]string{"tibc", types.ModuleName, "packet", "receive"},
+			1,
+			[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, newDenomPath)},
+		)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"tibc", types.ModuleName, "receive"},
+			1,
+			labels,
+		)
+	}()
+
+	return nil
+}
+
+// OnAcknowledgementPacket refunds the sender if the acknowledgement is an error
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet packettypes.Packet,
+	data types.FungibleTokenPacketData,
+	ack packettypes.Acknowledgement,
+) error {
+	switch ack.Response.(type) {
+	case *packettypes.Acknowledgement_Error:
+		return k.refundPacketToken(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// refundPacketToken unescrows the tokens sent away from their origin or mints
+// back the burnt vouchers to the sender
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet packettypes.Packet, data types.FungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	amount, err := data.ParseAmount()
+	if err != nil {
+		return err
+	}
+
+	token := sdk.NewCoins(sdk.NewCoin(types.ParseDenomTrace(data.Denom).IBCDenom(), amount))
+	if data.AwayFromOrigin {
+		return k.bk.SendCoins(ctx, k.GetEscrowAddress(packet.DestinationChain), sender, token)
+	}
+
+	if err := k.bk.MintCoins(ctx, types.ModuleName, token); err != nil {
+		return err
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, token)
+}
+
+// fullDenomPath returns the full denom path of the given denom. The denom must be
+// either a voucher or the min unit of a token issued by the token module.
+func (k Keeper) fullDenomPath(ctx sdk.Context, denom string) (string, error) {
+	if strings.HasPrefix(denom, types.DenomPrefix+DELIMITER) {
+		return k.DenomPathFromHash(ctx, denom)
+	}
+
+	token, err := k.tk.GetToken(ctx, denom)
+	if err != nil || token.GetMinUnit() != denom {
+		return "", sdkerrors.Wrapf(types.ErrInvalidDenom, "%s is not the min unit of a token", denom)
+	}
+	return denom, nil
+}
+
+// DenomPathFromHash returns the full denom path from a voucher denom with a hash component.
+func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
+	// trim the denom prefix, by default "tibc/"
+	hexHash := denom[len(types.DenomPrefix+DELIMITER):]
+
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+	return denomTrace.GetFullDenomPath(), nil
+}
+
+// getIBCDenomFromDenomPath stores the denom trace if needed and returns the voucher denom,
+// e.g. ft/A/B/denom -> tibc/hash(ft/A/B/denom)
+func (k Keeper) getIBCDenomFromDenomPath(ctx sdk.Context, denomPath string) string {
+	denomTrace := types.ParseDenomTrace(denomPath)
+	traceHash := denomTrace.Hash()
+
+	if !k.HasDenomTrace(ctx, traceHash) {
+		k.SetDenomTrace(ctx, denomTrace)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDenomTrace,
+				sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+				sdk.NewAttribute(types.AttributeKeyDenom, denomTrace.IBCDenom()),
+			),
+		)
+	}
+
+	return denomTrace.IBCDenom()
+}
+
+// validatePacketPath checks that the direction claimed by the packet matches the
+// one derived from the denom path and that the path was last extended towards
+// the packet source chain, e.g. ft/A/B/denom can only be received from B
+func validatePacketPath(packet packettypes.Packet, data types.FungibleTokenPacketData) error {
+	if away := determineAwayFromOrigin(data.Denom, packet.DestinationChain); away != data.AwayFromOrigin {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPacketPath, "away from origin %t of %s, expected %t", data.AwayFromOrigin, data.Denom, away,
+		)
+	}
+
+	if !strings.HasPrefix(data.Denom, types.DenomPathPrefix+DELIMITER) {
+		return nil
+	}
+
+	denomSplit := strings.Split(data.Denom, DELIMITER)
+	if lastHop := denomSplit[len(denomSplit)-2]; lastHop != packet.SourceChain {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPacketPath, "denom %s is held by %s, not by %s", data.Denom, lastHop, packet.SourceChain,
+		)
+	}
+	return nil
+}
+
+// determineAwayFromOrigin determines whether the token is sent from the source chain
+// or sent back to the source chain from other chains
+func determineAwayFromOrigin(denom, destChain string) (awayFromOrigin bool) {
+	/*
+		-- not has prefix
+		1. A -> B  denom:denom | sourceChain:A  | destChain:B |awayFromOrigin = true
+		-- has prefix
+		First take the source chain from the path (this path represents the path generated
+		from the source chain in the target chain), and then judge whether the source chain
+		is equal to the target chain, if it is equal, it means it is close to the source chain,
+		if it is not equal then indicates that we continue to stay away from the source chain
+
+		1. B -> C    denom:ft/A/B/denom 	| sourceChain:B  | destChain:C |awayFromOrigin = true
+		2. C -> B    denom:ft/A/B/C/denom	| sourceChain:C  | destChain:B |awayFromOrigin = false
+		3. B -> A    denom:ft/A/B/denom 	| sourceChain:B  | destChain:A |awayFromOrigin = false
+	*/
+	if !strings.HasPrefix(denom, types.DenomPathPrefix+DELIMITER) {
+		return true
+	}
+
+	denomSplit := strings.Split(denom, DELIMITER)
+	return denomSplit[len(denomSplit)-3] != destChain
+}
+
+// concatDenomPath returns ft/scChain/destChain/denom
+func concatDenomPath(scChain, destChain, denom string) string {
+	return strings.Join([]string{types.DenomPathPrefix, scChain, destChain, denom}, DELIMITER)
+}
+
+// getAwayNewDenomPath appends the destination chain to the denom path
+func getAwayNewDenomPath(scChain, destChain, denom string) string {
+	if strings.HasPrefix(denom, types.DenomPathPrefix+DELIMITER) {
+		// ft/A/B/denom -> ft/A/B/C/denom
+		denomSplit := strings.Split(denom, DELIMITER)
+		denomSplit = append(denomSplit[:len(denomSplit)-1], destChain, denomSplit[len(denomSplit)-1])
+		return strings.Join(denomSplit, DELIMITER)
+	}
+	// denom -> ft/A/B/denom
+	return concatDenomPath(scChain, destChain, denom)
+}
+
+// getBackNewDenomPath removes the last chain from the denom path
+func getBackNewDenomPath(denom string) string {
+	denomSplit := strings.Split(denom, DELIMITER)
+	if len(denomSplit) == 4 {
+		// ft/A/B/denom -> denom
+		return denomSplit[len(denomSplit)-1]
+	}
+	// ft/A/B/C/denom -> ft/A/B/denom
+	denomSplit = append(denomSplit[:len(denomSplit)-2], denomSplit[len(denomSplit)-1])
+	return strings.Join(denomSplit, DELIMITER)
+}
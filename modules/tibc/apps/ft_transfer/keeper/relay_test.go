@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetermineAwayFromOrigin(t *testing.T) {
+	testCases := []struct {
+		denom     string
+		destChain string
+		expAway   bool
+	}{
+		{"uirita", "B", true},
+		{"ft/A/B/uirita", "C", true},
+		{"ft/A/B/C/uirita", "B", false},
+		{"ft/A/B/uirita", "A", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expAway, determineAwayFromOrigin(tc.denom, tc.destChain), tc.denom)
+	}
+}
+
+func TestDenomPath(t *testing.T) {
+	require.Equal(t, "ft/A/B/uirita", getAwayNewDenomPath("A", "B", "uirita"))
+	require.Equal(t, "ft/A/B/C/uirita", getAwayNewDenomPath("B", "C", "ft/A/B/uirita"))
+
+	require.Equal(t, "ft/A/B/uirita", getBackNewDenomPath("ft/A/B/C/uirita"))
+	require.Equal(t, "uirita", getBackNewDenomPath("ft/A/B/uirita"))
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TIBC fungible token transfer sentinel errors
+var (
+	ErrInvalidDenom            = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 3, "invalid token amount")
+	ErrScChainEqualToDestChain = sdkerrors.Register(ModuleName, 4, "source chain equals to destination chain")
+	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 5, "denom trace not found")
+	ErrInvalidDenomTrace       = sdkerrors.Register(ModuleName, 6, "invalid denom trace")
+	ErrInvalidPacketPath       = sdkerrors.Register(ModuleName, 7, "packet chains mismatch the denom path")
+)
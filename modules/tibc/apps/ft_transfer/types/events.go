@@ -0,0 +1,18 @@
+package types
+
+// TIBC fungible token transfer events
+const (
+	EventTypePacket     = "fungible_token_packet"
+	EventTypeFtTransfer = "tibc_ft_transfer"
+	EventTypeDenomTrace = "denom_trace"
+	EventTypeRefund     = "refund"
+
+	AttributeKeyDenom      = "denom"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyDestChain  = "dest_chain"
+	AttributeKeyAck        = "ack"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+	AttributeKeyTraceHash  = "trace_hash"
+)
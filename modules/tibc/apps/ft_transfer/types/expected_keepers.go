@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/bianjieai/tibc-go/modules/tibc/core/exported"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error)
+}
+
+// PacketKeeper defines the expected packet keeper
+type PacketKeeper interface {
+	GetNextSequenceSend(ctx sdk.Context, sourceChain, destChain string) uint64
+	SendPacket(ctx sdk.Context, packet exported.PacketI) error
+}
+
+// ClientKeeper defines the expected client keeper
+type ClientKeeper interface {
+	GetChainName(ctx sdk.Context) string
+}
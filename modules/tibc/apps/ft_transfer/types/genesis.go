@@ -0,0 +1,18 @@
+package types
+
+// NewGenesisState constructs a new GenesisState instance
+func NewGenesisState(traces Traces) *GenesisState {
+	return &GenesisState{
+		DenomTraces: traces,
+	}
+}
+
+// DefaultGenesisState gets the default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(Traces{})
+}
+
+// Validate performs a basic genesis state validation
+func (gs GenesisState) Validate() error {
+	return gs.DenomTraces.Validate()
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	routingtypes "github.com/bianjieai/tibc-go/modules/tibc/core/26-routing/types"
+)
+
+const (
+	// ModuleName defines the TIBC fungible token transfer name
+	ModuleName = string(routingtypes.FT)
+
+	// RouterKey is the message route for the TIBC fungible token transfer
+	RouterKey = ModuleName
+
+	// PortID is the port id that the TIBC fungible token transfer binds to
+	PortID = routingtypes.FT
+
+	// StoreKey is the store key string for the TIBC fungible token transfer
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the TIBC fungible token transfer
+	QuerierRoute = ModuleName
+
+	// DenomPrefix is the prefix used for the voucher denoms
+	DenomPrefix = "tibc"
+
+	// DenomPathPrefix is the prefix of the full denom paths
+	DenomPathPrefix = "ft"
+)
+
+var (
+	// DenomTraceKey defines the key to store the denom trace info in store
+	DenomTraceKey = []byte{0x01}
+)
+
+// GetEscrowAddress returns the address escrowing the tokens sent away from their
+// origin to the given chain, so that a chain can only unescrow what was sent to it
+func GetEscrowAddress(chain string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(chain))
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgFtTransfer = "tibc_ft_transfer" // type for MsgFtTransfer
+)
+
+var _ sdk.Msg = &MsgFtTransfer{}
+
+// NewMsgFtTransfer creates a new MsgFtTransfer instance
+func NewMsgFtTransfer(token sdk.Coin, sender, receiver, destChain, relayChain string) *MsgFtTransfer {
+	return &MsgFtTransfer{
+		Token:      token,
+		Sender:     sender,
+		Receiver:   receiver,
+		DestChain:  destChain,
+		RelayChain: relayChain,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgFtTransfer) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgFtTransfer) Type() string { return TypeMsgFtTransfer }
+
+// ValidateBasic implements Msg.
+// NOTE: The receiver address format is not validated as it is defined by the
+// destination chain.
+func (msg MsgFtTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if strings.TrimSpace(msg.DestChain) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination chain cannot be blank")
+	}
+	if !msg.Token.IsValid() || !msg.Token.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidAmount, msg.Token.String())
+	}
+	return ValidateVoucherDenom(msg.Token.Denom)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgFtTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgFtTransfer) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFungibleTokenPacketData constructs a new FungibleTokenPacketData instance
+func NewFungibleTokenPacketData(denom, amount, sender, receiver string, awayFromOrigin bool) FungibleTokenPacketData {
+	return FungibleTokenPacketData{
+		Denom:          denom,
+		Amount:         amount,
+		Sender:         sender,
+		Receiver:       receiver,
+		AwayFromOrigin: awayFromOrigin,
+	}
+}
+
+// ValidateBasic is used for validating the fungible token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to TIBC.
+func (ftpd FungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if _, err := ftpd.ParseAmount(); err != nil {
+		return err
+	}
+	return ParseDenomTrace(ftpd.Denom).Validate()
+}
+
+// ParseAmount returns the transferred amount
+func (ftpd FungibleTokenPacketData) ParseAmount() (sdk.Int, error) {
+	amount, ok := sdk.NewIntFromString(ftpd.Amount)
+	if !ok || !amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalidAmount, "amount must be a positive integer: %s", ftpd.Amount)
+	}
+	return amount, nil
+}
+
+// GetBytes is a helper for serialising
+func (ftpd FungibleTokenPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshal(&ftpd)
+}
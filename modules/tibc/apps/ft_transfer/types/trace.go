@@ -0,0 +1,157 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Traces defines a wrapper type for a slice of DenomTrace.
+type Traces []DenomTrace
+
+var _ sort.Interface = Traces{}
+
+// Len implements sort.Interface for Traces
+func (t Traces) Len() int { return len(t) }
+
+// Less implements sort.Interface for Traces
+func (t Traces) Less(i, j int) bool { return t[i].GetFullDenomPath() < t[j].GetFullDenomPath() }
+
+// Swap implements sort.Interface for Traces
+func (t Traces) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// Sort is a helper function to sort the set of denom traces in-place
+func (t Traces) Sort() Traces {
+	sort.Sort(t)
+	return t
+}
+
+// Validate performs a basic validation of the denom traces
+func (t Traces) Validate() error {
+	seen := make(map[string]bool, len(t))
+	for _, trace := range t {
+		if err := trace.Validate(); err != nil {
+			return err
+		}
+
+		hash := trace.Hash().String()
+		if seen[hash] {
+			return fmt.Errorf("duplicated denom trace with hash %s", hash)
+		}
+		seen[hash] = true
+	}
+	return nil
+}
+
+// ParseDenomTrace parses a string with the tibc prefix (denom trace) and the base denom
+// into a DenomTrace type.
+//
+// Examples:
+//
+//   - "ft/A/B/uirita" => DenomTrace{Path: "ft/A/B", BaseDenom: "uirita"}
+//   - "uirita" => DenomTrace{Path: "", BaseDenom: "uirita"}
+func ParseDenomTrace(rawDenom string) DenomTrace {
+	denomSplit := strings.Split(rawDenom, "/")
+
+	if denomSplit[0] == rawDenom {
+		return DenomTrace{
+			Path:      "",
+			BaseDenom: rawDenom,
+		}
+	}
+
+	return DenomTrace{
+		Path:      strings.Join(denomSplit[:len(denomSplit)-1], "/"),
+		BaseDenom: denomSplit[len(denomSplit)-1],
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the DenomTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseDenom)
+func (dt DenomTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(dt.GetFullDenomPath()))
+	return hash[:]
+}
+
+// GetFullDenomPath returns the full denom path: tracePath + "/" + baseDenom.
+// If there exists no trace then the base denom is returned.
+func (dt DenomTrace) GetFullDenomPath() string {
+	if dt.Path == "" {
+		return dt.BaseDenom
+	}
+	return dt.GetPrefix() + dt.BaseDenom
+}
+
+// GetPrefix returns the receiving denom prefix composed by the trace info and a separator.
+func (dt DenomTrace) GetPrefix() string {
+	return dt.Path + "/"
+}
+
+// IBCDenom returns the voucher denom of the fungible token in the format
+// 'tibc/{hash(tracePath + baseDenom)}'. If the trace is empty, it will return the base denom.
+func (dt DenomTrace) IBCDenom() string {
+	if dt.Path != "" {
+		return fmt.Sprintf("%s/%s", DenomPrefix, dt.Hash())
+	}
+	return dt.BaseDenom
+}
+
+// Validate performs a basic validation of the denom trace. The path must be
+// composed of the path prefix followed by the source and destination chains.
+func (dt DenomTrace) Validate() error {
+	if err := sdk.ValidateDenom(dt.BaseDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomTrace, err.Error())
+	}
+	if dt.Path == "" {
+		return nil
+	}
+
+	pathSplit := strings.Split(dt.Path, "/")
+	if pathSplit[0] != DenomPathPrefix || len(pathSplit) < 3 {
+		return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid trace path %s", dt.Path)
+	}
+	for _, chain := range pathSplit[1:] {
+		if strings.TrimSpace(chain) == "" {
+			return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid trace path %s", dt.Path)
+		}
+	}
+	return nil
+}
+
+// ValidateVoucherDenom validates a denom which is either a base denom or a
+// voucher denom in the format 'tibc/{hash}'
+func ValidateVoucherDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	if strings.HasPrefix(denom, DenomPrefix+"/") {
+		if _, err := ParseHexHash(strings.TrimPrefix(denom, DenomPrefix+"/")); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "invalid voucher denom %s: %s", denom, err)
+		}
+	}
+	return nil
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its correctness.
+func ParseHexHash(hexHash string) (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmtypes.ValidateHash(hash); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
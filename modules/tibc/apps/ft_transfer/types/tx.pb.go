@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tibc/ft_transfer/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgFtTransfer defines a message to transfer fungible tokens to another chain
+type MsgFtTransfer struct {
+	// the token to be transferred
+	Token types.Coin `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	// the token sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the token receiver
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// target chain of transmission
+	DestChain string `protobuf:"bytes,4,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain,omitempty"`
+	// relay chain during transmission
+	RelayChain string `protobuf:"bytes,5,opt,name=relay_chain,json=relayChain,proto3" json:"relay_chain,omitempty"`
+}
+
+func (m *MsgFtTransfer) Reset()         { *m = MsgFtTransfer{} }
+func (m *MsgFtTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgFtTransfer) ProtoMessage()    {}
+func (*MsgFtTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec9990fc0b70c07, []int{0}
+}
+func (m *MsgFtTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFtTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFtTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFtTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFtTransfer.Merge(m, src)
+}
+func (m *MsgFtTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFtTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFtTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFtTransfer proto.InternalMessageInfo
+
+// MsgFtTransferResponse defines the Msg/FtTransfer response type
+type MsgFtTransferResponse struct {
+}
+
+func (m *MsgFtTransferResponse) Reset()         { *m = MsgFtTransferResponse{} }
+func (m *MsgFtTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFtTransferResponse) ProtoMessage()    {}
+func (*MsgFtTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec9990fc0b70c07, []int{1}
+}
+func (m *MsgFtTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFtTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFtTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFtTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFtTransferResponse.Merge(m, src)
+}
+func (m *MsgFtTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFtTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFtTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFtTransferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgFtTransfer)(nil), "irita.tibc.ft_transfer.MsgFtTransfer")
+	proto.RegisterType((*MsgFtTransferResponse)(nil), "irita.tibc.ft_transfer.MsgFtTransferResponse")
+}
+
+func init() { proto.RegisterFile("tibc/ft_transfer/tx.proto", fileDescriptor_8ec9990fc0b70c07) }
+
+var fileDescriptor_8ec9990fc0b70c07 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x6f, 0x1a, 0x31,
+	0x18, 0xc6, 0xcf, 0xe5, 0x8f, 0x8a, 0x51, 0x97, 0x53, 0x4b, 0x8f, 0x93, 0x6a, 0x10, 0x52, 0x25,
+	0x96, 0xda, 0x82, 0xaa, 0x4b, 0x87, 0x0e, 0x20, 0x75, 0x63, 0x41, 0x99, 0xa2, 0x48, 0xc8, 0x77,
+	0xbc, 0x1c, 0x4e, 0xc0, 0x3e, 0xd9, 0x06, 0x85, 0x6f, 0x91, 0x8f, 0x90, 0x8f, 0x92, 0x91, 0x91,
+	0x31, 0x53, 0x94, 0xc0, 0x92, 0x8f, 0x11, 0x9d, 0xef, 0x12, 0x81, 0x94, 0x21, 0x9b, 0xdf, 0xe7,
+	0xf9, 0xe9, 0x79, 0xf5, 0xfa, 0xc1, 0x4d, 0x2b, 0xa2, 0x98, 0xcd, 0xec, 0xc4, 0x6a, 0x2e, 0xcd,
+	0x0c, 0x34, 0xb3, 0xd7, 0x34, 0xd5, 0xca, 0x2a, 0xbf, 0x21, 0xb4, 0xb0, 0x9c, 0x66, 0x00, 0x3d,
+	0x02, 0xc2, 0xaf, 0x89, 0x4a, 0x94, 0x43, 0x58, 0xf6, 0xca, 0xe9, 0x90, 0xc4, 0xca, 0x2c, 0x95,
+	0x61, 0x11, 0x37, 0xc0, 0xd6, 0xbd, 0x08, 0x2c, 0xef, 0xb1, 0x58, 0x09, 0x99, 0xfb, 0x9d, 0x3b,
+	0x84, 0xbf, 0x8c, 0x4c, 0xf2, 0xdf, 0x9e, 0x15, 0x39, 0xfe, 0x1f, 0x5c, 0xb1, 0xea, 0x0a, 0x64,
+	0x80, 0xda, 0xa8, 0x5b, 0xef, 0x37, 0x69, 0x9e, 0x40, 0xb3, 0x04, 0x5a, 0x24, 0xd0, 0xa1, 0x12,
+	0x72, 0x50, 0xde, 0x3e, 0xb4, 0xbc, 0x71, 0x4e, 0xfb, 0x0d, 0x5c, 0x35, 0x20, 0xa7, 0xa0, 0x83,
+	0x4f, 0x6d, 0xd4, 0xad, 0x8d, 0x8b, 0xc9, 0x0f, 0xf1, 0x67, 0x0d, 0x31, 0x88, 0x35, 0xe8, 0xa0,
+	0xe4, 0x9c, 0xb7, 0xd9, 0xff, 0x81, 0xf1, 0x14, 0x8c, 0x9d, 0xc4, 0x73, 0x2e, 0x64, 0x50, 0x76,
+	0x6e, 0x2d, 0x53, 0x86, 0x99, 0xe0, 0xb7, 0x70, 0x5d, 0xc3, 0x82, 0x6f, 0x0a, 0xbf, 0xe2, 0x7c,
+	0xec, 0x24, 0x07, 0xfc, 0x2d, 0x3f, 0xdf, 0xb6, 0x50, 0xe7, 0x3b, 0xfe, 0x76, 0x72, 0xc1, 0x18,
+	0x4c, 0xaa, 0xa4, 0x81, 0xbe, 0xc0, 0xa5, 0x91, 0x49, 0xfc, 0x08, 0xe3, 0xa3, 0xf3, 0x7e, 0xd2,
+	0xf7, 0xff, 0x8f, 0x9e, 0x64, 0x84, 0xbf, 0x3e, 0x84, 0xbd, 0xae, 0x1a, 0x5c, 0x6c, 0x9f, 0x88,
+	0xb7, 0xdd, 0x13, 0xb4, 0xdb, 0x13, 0xf4, 0xb8, 0x27, 0xe8, 0xe6, 0x40, 0xbc, 0xdd, 0x81, 0x78,
+	0xf7, 0x07, 0xe2, 0x9d, 0xff, 0x4b, 0x84, 0x9d, 0xaf, 0x22, 0x1a, 0xab, 0x25, 0x8b, 0x04, 0x97,
+	0x97, 0x02, 0xb8, 0x60, 0x6e, 0x01, 0x5b, 0xaa, 0xe9, 0x6a, 0x01, 0x86, 0xb9, 0xc2, 0x79, 0x9a,
+	0x9a, 0xd3, 0xd6, 0x37, 0x29, 0x98, 0xa8, 0xea, 0xba, 0xfa, 0xfd, 0x32, 0x00, 0x5e, 0x45, 0x10,
+	0x2f, 0x16, 0x02, 0x00, 0x00,
+}
+
+func (this *MsgFtTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFtTransfer)
+	if !ok {
+		that2, ok := that.(MsgFtTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Token.Equal(&that1.Token) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.DestChain != that1.DestChain {
+		return false
+	}
+	if this.RelayChain != that1.RelayChain {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// FtTransfer defines a method for transferring fungible tokens to another chain
+	FtTransfer(ctx context.Context, in *MsgFtTransfer, opts ...grpc.CallOption) (*MsgFtTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FtTransfer(ctx context.Context, in *MsgFtTransfer, opts ...grpc.CallOption) (*MsgFtTransferResponse, error) {
+	out := new(MsgFtTransferResponse)
+	err := c.cc.Invoke(ctx, "/irita.tibc.ft_transfer.Msg/FtTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// FtTransfer defines a method for transferring fungible tokens to another chain
+	FtTransfer(context.Context, *MsgFtTransfer) (*MsgFtTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FtTransfer(ctx context.Context, req *MsgFtTransfer) (*MsgFtTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FtTransfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FtTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFtTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FtTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irita.tibc.ft_transfer.Msg/FtTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FtTransfer(ctx, req.(*MsgFtTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irita.tibc.ft_transfer.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FtTransfer",
+			Handler:    _Msg_FtTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tibc/ft_transfer/tx.proto",
+}
+
+func (m *MsgFtTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFtTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFtTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayChain) > 0 {
+		i -= len(m.RelayChain)
+		copy(dAtA[i:], m.RelayChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RelayChain)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestChain) > 0 {
+		i -= len(m.DestChain)
+		copy(dAtA[i:], m.DestChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestChain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFtTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFtTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFtTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFtTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RelayChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFtTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFtTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFtTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFtTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFtTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFtTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFtTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -16,16 +16,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case *clienttypes.MsgCreateClient:
-			res, err := k.CreateClient(ctx.Context(), msg)
+			res, err := k.CreateClient(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *clienttypes.MsgUpgradeClient:
-			res, err := k.UpgradeClient(ctx.Context(), msg)
+			res, err := k.UpgradeClient(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *clienttypes.MsgRegisterRelayer:
-			res, err := k.RegisterRelayer(ctx.Context(), msg)
+			res, err := k.RegisterRelayer(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *clienttypes.MsgSetRoutingRules:
-			res, err := k.SetRoutingRules(ctx.Context(), msg)
+			res, err := k.SetRoutingRules(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
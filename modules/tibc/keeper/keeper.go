@@ -7,6 +7,8 @@ import (
 	"github.com/irisnet/irismod/modules/nft/exported"
 	nftkeeper "github.com/irisnet/irismod/modules/nft/keeper"
 	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+
+	"github.com/bianjieai/irita/modules/tibc/types"
 )
 
 var _ tibcnfttypes.NftKeeper = NFTKeeper{}
@@ -15,6 +17,7 @@ var _ tibcnfttypes.NftKeeper = NFTKeeper{}
 type (
 	Keeper struct {
 		*tibckeeper.Keeper
+		permKeeper types.PermKeeper
 	}
 
 	NFTKeeper struct {
@@ -22,8 +25,11 @@ type (
 	}
 )
 
-func NewKeeper(k *tibckeeper.Keeper) *Keeper {
-	return &Keeper{k}
+func NewKeeper(k *tibckeeper.Keeper, permKeeper types.PermKeeper) *Keeper {
+	return &Keeper{
+		Keeper:     k,
+		permKeeper: permKeeper,
+	}
 }
 
 func WrapNftKeeper(nk nftkeeper.Keeper) NFTKeeper {
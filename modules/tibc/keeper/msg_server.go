@@ -5,11 +5,17 @@ import (
 
 	clienttypes "github.com/bianjieai/tibc-go/modules/tibc/core/02-client/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
 	"github.com/bianjieai/irita/modules/tibc/types"
 )
 
 func (k Keeper) CreateClient(ctx context.Context, msg *types.MsgCreateClient) (*types.MsgCreateClientResponse, error) {
+	if err := k.authorize(sdk.UnwrapSDKContext(ctx), msg.Signer); err != nil {
+		return &types.MsgCreateClientResponse{}, err
+	}
+
 	clientState, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return &types.MsgCreateClientResponse{}, err
@@ -27,6 +33,10 @@ func (k Keeper) CreateClient(ctx context.Context, msg *types.MsgCreateClient) (*
 }
 
 func (k Keeper) UpgradeClient(ctx context.Context, msg *types.MsgUpgradeClient) (*types.MsgUpgradeClientResponse, error) {
+	if err := k.authorize(sdk.UnwrapSDKContext(ctx), msg.Signer); err != nil {
+		return &types.MsgUpgradeClientResponse{}, err
+	}
+
 	clientState, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return &types.MsgUpgradeClientResponse{}, err
@@ -46,6 +56,9 @@ func (k Keeper) UpgradeClient(ctx context.Context, msg *types.MsgUpgradeClient)
 }
 
 func (k Keeper) RegisterRelayer(ctx context.Context, msg *types.MsgRegisterRelayer) (*types.MsgRegisterRelayerResponse, error) {
+	if err := k.authorize(sdk.UnwrapSDKContext(ctx), msg.Signer); err != nil {
+		return nil, err
+	}
 
 	k.ClientKeeper.RegisterRelayers(
 		sdk.UnwrapSDKContext(ctx),
@@ -56,6 +69,9 @@ func (k Keeper) RegisterRelayer(ctx context.Context, msg *types.MsgRegisterRelay
 }
 
 func (k Keeper) SetRoutingRules(ctx context.Context, msg *types.MsgSetRoutingRules) (*types.MsgSetRoutingRulesResponse, error) {
+	if err := k.authorize(sdk.UnwrapSDKContext(ctx), msg.Signer); err != nil {
+		return nil, err
+	}
 
 	err := k.RoutingKeeper.SetRoutingRules(
 		sdk.UnwrapSDKContext(ctx),
@@ -66,3 +82,15 @@ func (k Keeper) SetRoutingRules(ctx context.Context, msg *types.MsgSetRoutingRul
 	}
 	return &types.MsgSetRoutingRulesResponse{}, nil
 }
+
+// authorize checks that the signer of an admin message is a TIBC admin
+func (k Keeper) authorize(ctx sdk.Context, signer string) error {
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if !k.permKeeper.IsAuthorized(ctx, addr, permtypes.RoleTIBCAdmin) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a TIBC admin", signer)
+	}
+	return nil
+}
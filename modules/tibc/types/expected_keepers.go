@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+)
+
+// PermKeeper defines the expected perm keeper
+type PermKeeper interface {
+	IsAuthorized(ctx sdk.Context, address sdk.AccAddress, roles ...permtypes.Role) bool
+}
@@ -150,6 +150,9 @@ func (m MsgRegisterRelayer) Type() string {
 
 // ValidateBasic implements Msg.
 func (m MsgRegisterRelayer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
 	if err := tibchost.ClientIdentifierValidator(m.ChainName); err != nil {
 		return err
 	}
@@ -216,6 +219,9 @@ func (m MsgSetRoutingRules) GetSigners() []sdk.AccAddress {
 
 // ValidateBasic runs basic stateless validity checks
 func (cup MsgSetRoutingRules) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(cup.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
 	if err := tibchost.RoutingRulesValidator(cup.Rules); err != nil {
 		return err
 	}
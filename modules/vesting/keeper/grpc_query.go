@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/bianjieai/irita/modules/vesting/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the vesting gRPC querier service
+type Querier struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewQuerier creates a new vesting Querier instance
+func NewQuerier(ak types.AccountKeeper, bk types.BankKeeper) Querier {
+	return Querier{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Balances queries the locked and spendable balances of the given account
+func (q Querier) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	total := q.bankKeeper.GetAllBalances(ctx, address)
+	spendable := q.bankKeeper.SpendableCoins(ctx, address)
+
+	_, vesting := q.accountKeeper.GetAccount(ctx, address).(vestingexported.VestingAccount)
+
+	return &types.QueryBalancesResponse{
+		Total:     total,
+		Locked:    total.Sub(spendable),
+		Spendable: spendable,
+		Vesting:   vesting,
+	}, nil
+}
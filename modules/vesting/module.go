@@ -0,0 +1,105 @@
+package vesting
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+
+	"github.com/bianjieai/irita/modules/vesting/client/cli"
+	"github.com/bianjieai/irita/modules/vesting/keeper"
+	"github.com/bianjieai/irita/modules/vesting/simulation"
+	"github.com/bianjieai/irita/modules/vesting/types"
+)
+
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the vesting module.
+// It extends the sdk vesting module with the balances query.
+type AppModuleBasic struct {
+	vesting.AppModuleBasic
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the vesting module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	_ = types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+}
+
+// GetQueryCmd returns the root query command for the vesting module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// ____________________________________________________________________________
+
+// AppModule implements an application module for the vesting module.
+type AppModule struct {
+	vesting.AppModule
+
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(ak authkeeper.AccountKeeper, bk types.BankKeeper) AppModule {
+	return AppModule{
+		AppModule:     vesting.NewAppModule(ak, bk),
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the vesting module.
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	AppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, mux)
+}
+
+// GetQueryCmd returns the root query command for the vesting module.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return AppModuleBasic{}.GetQueryCmd()
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(cfg)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.accountKeeper, am.bankKeeper))
+}
+
+// ____________________________________________________________________________
+
+// GenerateGenesisState creates a randomized GenState of the vesting module.
+// The vesting accounts themselves are generated along with the auth genesis accounts.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simState.GenState[types.ModuleName] = json.RawMessage("{}")
+}
+
+// ProposalContents doesn't return any content functions for governance proposals.
+func (AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams doesn't create any randomized vesting param changes for the simulator.
+func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder doesn't register any store decoder as the vesting module has no store.
+func (AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the all the vesting module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper)
+}
@@ -0,0 +1,86 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/bianjieai/irita/modules/vesting/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount = "op_weight_msg_create_vesting_account"
+
+	DefaultWeightMsgCreateVestingAccount = 20
+)
+
+var TypeMsgCreateVestingAccount = sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{})
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak simulation.AccountKeeper, bk simulation.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateVestingAccount int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount with random values
+// vesting a part of the balance of a random account to a new account.
+func SimulateMsgCreateVestingAccount(ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to := simtypes.RandomAccounts(r, 1)[0]
+
+		if ak.GetAccount(ctx, to.Address) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateVestingAccount, "account already exists"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, from.Address)
+		amount := simtypes.RandSubsetCoins(r, spendable)
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateVestingAccount, "empty coins"), nil, nil
+		}
+
+		endTime := ctx.BlockTime().Add(simtypes.RandTimestamp(r).Sub(simtypes.RandTimestamp(r)).Abs()).Unix()
+		msg := vestingtypes.NewMsgCreateVestingAccount(from.Address, to.Address, amount, endTime, r.Intn(2) == 0)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         TypeMsgCreateVestingAccount,
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: amount,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
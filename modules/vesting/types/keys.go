@@ -0,0 +1,13 @@
+package types
+
+import (
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// ModuleName is the name of the vesting module
+	ModuleName = vestingtypes.ModuleName
+
+	// RouterKey is the msg router key for the vesting module
+	RouterKey = vestingtypes.RouterKey
+)
@@ -81,6 +81,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/bianjieai/irita/lite"
+	"github.com/bianjieai/irita/modules/perm"
+	permkeeper "github.com/bianjieai/irita/modules/perm/keeper"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
 	tibc "github.com/bianjieai/irita/modules/tibc"
 	tibckeeper "github.com/bianjieai/irita/modules/tibc/keeper"
 	"github.com/bianjieai/iritamod/modules/genutil"
@@ -127,6 +130,7 @@ var (
 		random.AppModuleBasic{},
 		identity.AppModuleBasic{},
 		node.AppModuleBasic{},
+		perm.AppModuleBasic{},
 		tibc.AppModule{},
 		tibcnfttransfer.AppModuleBasic{},
 	)
@@ -179,6 +183,7 @@ type SimApp struct {
 	RandomKeeper      randomkeeper.Keeper
 	IdentityKeeper    identitykeeper.Keeper
 	NodeKeeper        nodekeeper.Keeper
+	PermKeeper        permkeeper.Keeper
 	FeeGrantKeeper    feegrantkeeper.Keeper
 	TIBCKeeper        *tibckeeper.Keeper // TIBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	NftTransferKeeper tibcnfttransferkeeper.Keeper
@@ -237,6 +242,7 @@ func NewSimApp(
 		randomtypes.StoreKey,
 		identitytypes.StoreKey,
 		nodetypes.StoreKey,
+		permtypes.StoreKey,
 
 		tibchost.StoreKey,
 		tibcnfttypes.StoreKey,
@@ -309,6 +315,7 @@ func NewSimApp(
 	)
 
 	app.IdentityKeeper = identitykeeper.NewKeeper(appCodec, keys[identitytypes.StoreKey])
+	app.PermKeeper = permkeeper.NewKeeper(appCodec, keys[permtypes.StoreKey], nil)
 
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
@@ -325,7 +332,7 @@ func NewSimApp(
 		appCodec, keys[tibchost.StoreKey], app.GetSubspace(tibchost.ModuleName), stakingkeeper.Keeper{},
 	)
 	// Create TIBC Keeper
-	app.TIBCKeeper = tibckeeper.NewKeeper(tibccorekeeper)
+	app.TIBCKeeper = tibckeeper.NewKeeper(tibccorekeeper, app.PermKeeper)
 	tibcmockModule := tibcmock.NewAppModule()
 	tibcRouter := tibcroutingtypes.NewRouter()
 	tibcRouter.AddRoute(tibcnfttypes.ModuleName, nfttransferModule)
@@ -358,6 +365,7 @@ func NewSimApp(
 		identity.NewAppModule(app.IdentityKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		node.NewAppModule(appCodec, app.NodeKeeper),
+		perm.NewAppModule(app.PermKeeper),
 		tibc.NewAppModule(app.TIBCKeeper),
 		nfttransferModule,
 	)
@@ -400,6 +408,7 @@ func NewSimApp(
 		oracletypes.ModuleName,
 		randomtypes.ModuleName,
 		identitytypes.ModuleName,
+		permtypes.ModuleName,
 
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
@@ -0,0 +1,118 @@
+// Package testutil provides the fixtures shared by the module keeper tests
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	permkeeper "github.com/bianjieai/irita/modules/perm/keeper"
+	permtypes "github.com/bianjieai/irita/modules/perm/types"
+	proposaltypes "github.com/bianjieai/irita/modules/proposal/types"
+)
+
+// MinterName is the module account minting the coins funding the test accounts
+const MinterName = "minter"
+
+// KeeperEnv holds the stores and the auth, bank and perm keepers on which the
+// module keepers under test are built
+type KeeperEnv struct {
+	Ctx            sdk.Context
+	EncodingConfig params.EncodingConfig
+	Cdc            codec.Codec
+
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.BaseKeeper
+	PermKeeper    permkeeper.Keeper
+
+	keys       map[string]*sdk.KVStoreKey
+	paramsKey  *sdk.KVStoreKey
+	paramsTKey *sdk.TransientStoreKey
+}
+
+// NewKeeperEnv mounts the auth, bank, params and perm stores along with the given
+// module stores on an in-memory db. The module accounts are granted the given
+// permissions, the minter being always added. As in the app, the proposal module
+// account is the perm authority.
+//
+// The codec only knows the std, auth, bank and perm types, the tests registering
+// the interfaces of the modules under test.
+func NewKeeperEnv(t *testing.T, maccPerms map[string][]string, storeKeys ...string) *KeeperEnv {
+	encodingConfig := params.MakeTestEncodingConfig()
+	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	authtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	permtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	env := &KeeperEnv{
+		EncodingConfig: encodingConfig,
+		Cdc:            encodingConfig.Marshaler,
+		keys:           make(map[string]*sdk.KVStoreKey),
+		paramsKey:      sdk.NewKVStoreKey(paramstypes.StoreKey),
+		paramsTKey:     sdk.NewTransientStoreKey(paramstypes.TStoreKey),
+	}
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(env.paramsKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(env.paramsTKey, sdk.StoreTypeTransient, nil)
+	for _, name := range append([]string{authtypes.StoreKey, banktypes.StoreKey, permtypes.StoreKey}, storeKeys...) {
+		env.keys[name] = sdk.NewKVStoreKey(name)
+		ms.MountStoreWithDB(env.keys[name], sdk.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	env.Ctx = sdk.NewContext(ms, tmproto.Header{Time: time.Now().UTC()}, false, log.NewNopLogger())
+
+	perms := map[string][]string{MinterName: {authtypes.Minter}}
+	for name, p := range maccPerms {
+		perms[name] = p
+	}
+	env.AccountKeeper = authkeeper.NewAccountKeeper(
+		env.Cdc, env.keys[authtypes.StoreKey], env.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, perms,
+	)
+	env.BankKeeper = bankkeeper.NewBaseKeeper(
+		env.Cdc, env.keys[banktypes.StoreKey], env.AccountKeeper, env.Subspace(banktypes.ModuleName), nil,
+	)
+	env.BankKeeper.SetParams(env.Ctx, banktypes.DefaultParams())
+	env.PermKeeper = permkeeper.NewKeeper(env.Cdc, env.keys[permtypes.StoreKey], authtypes.NewModuleAddress(proposaltypes.ModuleName))
+	return env
+}
+
+// Key returns the key of the mounted store of the given name
+func (env *KeeperEnv) Key(name string) *sdk.KVStoreKey {
+	return env.keys[name]
+}
+
+// Subspace returns the params subspace of the given module
+func (env *KeeperEnv) Subspace(name string) paramstypes.Subspace {
+	return paramstypes.NewSubspace(env.Cdc, env.EncodingConfig.Amino, env.paramsKey, env.paramsTKey, name)
+}
+
+// Fund mints the coins to the account
+func (env *KeeperEnv) Fund(t *testing.T, addr sdk.AccAddress, coins ...sdk.Coin) {
+	amt := sdk.NewCoins(coins...)
+	require.NoError(t, env.BankKeeper.MintCoins(env.Ctx, MinterName, amt))
+	require.NoError(t, env.BankKeeper.SendCoinsFromModuleToAccount(env.Ctx, MinterName, addr, amt))
+}
+
+// Addr returns the test account address derived from the seed
+func Addr(seed string) sdk.AccAddress {
+	return sdk.AccAddress(tmhash.SumTruncated([]byte(seed)))
+}